@@ -0,0 +1,275 @@
+package casper
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+)
+
+// CSPRDecimals is the number of the decimal places of CSPR, 1 CSPR is 10^9 motes.
+const CSPRDecimals = 9
+
+const (
+	UnitCSPR  = "CSPR"
+	UnitMotes = "motes"
+)
+
+var (
+	ErrInvalidAmount  = errors.New("invalid amount")
+	ErrAmountOverflow = errors.New("amount is negative or exceeds U512")
+)
+
+// maxAmount is the biggest amount of motes representable by U512.
+var maxAmount = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 512), big.NewInt(1))
+
+// Amount is the exact amount of motes backed by *big.Int, it is never negative and fits U512.
+// The zero value is 0 motes, the methods of Amount don't modify it.
+type Amount struct {
+	motes *big.Int
+}
+
+// NewAmount returns the amount of the motes, the number is copied.
+func NewAmount(motes *big.Int) (Amount, error) {
+	if motes == nil {
+		return Amount{}, nil
+	}
+	return checkedAmount(new(big.Int).Set(motes))
+}
+
+// NewAmountFromMotes returns the amount of the motes.
+func NewAmountFromMotes(motes uint64) Amount {
+	return Amount{motes: new(big.Int).SetUint64(motes)}
+}
+
+// NewAmountFromCSPR returns the amount of the whole CSPR.
+func NewAmountFromCSPR(cspr uint64) Amount {
+	motes := new(big.Int).SetUint64(cspr)
+	return Amount{motes: motes.Mul(motes, big.NewInt(1_000_000_000))}
+}
+
+// ParseAmount parses the amount with its unit, e.g. "1.5 CSPR", "1500000000 motes" or "1_000 CSPR".
+// The number without the unit is the number of motes, the unit is case-insensitive and the underscores separate the digits.
+// The fractional motes are rejected, so the parsed amount is always exact.
+func ParseAmount(source string) (Amount, error) {
+	number, unit := strings.TrimSpace(source), UnitMotes
+	var decimals int32
+	for _, one := range []string{UnitCSPR, UnitMotes, "mote"} {
+		if len(number) >= len(one) && strings.EqualFold(number[len(number)-len(one):], one) {
+			number, unit = strings.TrimSpace(number[:len(number)-len(one)]), one
+			if one == UnitCSPR {
+				decimals = CSPRDecimals
+			}
+			break
+		}
+	}
+
+	digits, err := removeDigitSeparators(number)
+	if err != nil {
+		return Amount{}, err
+	}
+	value, err := decimal.NewFromString(digits)
+	if err != nil || strings.ContainsAny(digits, "eE") {
+		return Amount{}, fmt.Errorf("%w, details: %q is not a decimal number", ErrInvalidAmount, number)
+	}
+	value = value.Shift(decimals)
+	if !value.IsInteger() {
+		return Amount{}, fmt.Errorf("%w, details: %s %s has fractional motes", ErrInvalidAmount, number, unit)
+	}
+	return checkedAmount(value.BigInt())
+}
+
+// removeDigitSeparators removes the underscores, each of them must be placed between two digits.
+func removeDigitSeparators(number string) (string, error) {
+	if !strings.Contains(number, "_") {
+		return number, nil
+	}
+	isDigit := func(index int) bool {
+		return index >= 0 && index < len(number) && number[index] >= '0' && number[index] <= '9'
+	}
+	for i := range number {
+		if number[i] == '_' && (!isDigit(i-1) || !isDigit(i+1)) {
+			return "", fmt.Errorf("%w, details: misplaced '_' in %q", ErrInvalidAmount, number)
+		}
+	}
+	return strings.ReplaceAll(number, "_", ""), nil
+}
+
+func checkedAmount(motes *big.Int) (Amount, error) {
+	if motes.Sign() < 0 || motes.Cmp(maxAmount) > 0 {
+		return Amount{}, fmt.Errorf("%w, motes: %s", ErrAmountOverflow, motes.String())
+	}
+	return Amount{motes: motes}, nil
+}
+
+// Motes returns the copy of the number of motes.
+func (a Amount) Motes() *big.Int {
+	if a.motes == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(a.motes)
+}
+
+// Uint64 returns the number of motes as uint64, e.g. for the payment amount of the PricingMode, the bigger amounts are rejected.
+func (a Amount) Uint64() (uint64, error) {
+	motes := a.Motes()
+	if !motes.IsUint64() {
+		return 0, fmt.Errorf("%w, details: %s motes exceeds uint64", ErrAmountOverflow, motes.String())
+	}
+	return motes.Uint64(), nil
+}
+
+// CSPR returns the exact amount in CSPR.
+func (a Amount) CSPR() decimal.Decimal {
+	return decimal.NewFromBigInt(a.Motes(), -CSPRDecimals)
+}
+
+// FormatCSPR formats the amount in CSPR with the number of decimal places, the extra places are truncated, e.g. "1.50".
+func (a Amount) FormatCSPR(decimals int32) string {
+	return a.CSPR().Truncate(decimals).StringFixed(decimals)
+}
+
+// String formats the exact amount in CSPR with the unit, e.g. "1.5 CSPR", which is parsed back by ParseAmount.
+func (a Amount) String() string {
+	return a.CSPR().String() + " " + UnitCSPR
+}
+
+func (a Amount) IsZero() bool {
+	return a.motes == nil || a.motes.Sign() == 0
+}
+
+// Cmp returns -1, 0 or 1 when the amount is less than, equal to or greater than the other.
+func (a Amount) Cmp(other Amount) int {
+	return a.Motes().Cmp(other.Motes())
+}
+
+// Add returns the sum of the amounts, the sum which exceeds U512 is rejected with ErrAmountOverflow.
+func (a Amount) Add(other Amount) (Amount, error) {
+	return checkedAmount(new(big.Int).Add(a.Motes(), other.Motes()))
+}
+
+// Sub returns the difference of the amounts, the negative difference is rejected with ErrAmountOverflow.
+func (a Amount) Sub(other Amount) (Amount, error) {
+	return checkedAmount(new(big.Int).Sub(a.Motes(), other.Motes()))
+}
+
+// Mul returns the amount multiplied by the factor, the product which exceeds U512 is rejected with ErrAmountOverflow.
+func (a Amount) Mul(factor uint64) (Amount, error) {
+	return checkedAmount(new(big.Int).Mul(a.Motes(), new(big.Int).SetUint64(factor)))
+}
+
+// CLValue returns the amount as U512, the type of the amount arguments.
+func (a Amount) CLValue() clvalue.CLValue {
+	return *clvalue.NewCLUInt512(a.Motes())
+}
+
+// UInt512 returns the amount as clvalue.UInt512.
+func (a Amount) UInt512() clvalue.UInt512 {
+	return *a.CLValue().UI512
+}
+
+// NewAmountFromUInt512 returns the amount of the motes of the clvalue.UInt512, e.g. a balance returned by the node.
+func NewAmountFromUInt512(value clvalue.UInt512) (Amount, error) {
+	return NewAmount(value.Value())
+}
+
+// NewAmountFromCLValue returns the amount of the motes of the U128, U256 or U512 value.
+func NewAmountFromCLValue(value clvalue.CLValue) (Amount, error) {
+	var motes *big.Int
+	if err := clvalue.Unmarshal(value, &motes); err != nil {
+		return Amount{}, err
+	}
+	return NewAmount(motes)
+}
+
+// StandardPayment returns the standard payment of the amount for the Deploy.
+func (a Amount) StandardPayment() types.ExecutableDeployItem {
+	return types.StandardPayment(a.Motes())
+}
+
+// NewAmountFromPayment returns the amount argument of the standard payment.
+func NewAmountFromPayment(payment types.ExecutableDeployItem) (Amount, error) {
+	if payment.ModuleBytes == nil || payment.ModuleBytes.Args == nil {
+		return Amount{}, fmt.Errorf("%w, details: payment is not the standard payment", ErrInvalidAmount)
+	}
+	arg, err := payment.ModuleBytes.Args.Find("amount")
+	if err != nil {
+		return Amount{}, err
+	}
+	value, err := arg.Value()
+	if err != nil {
+		return Amount{}, err
+	}
+	return NewAmountFromCLValue(value)
+}
+
+// MarshalJSON encodes the amount as the decimal string of motes, the same way as the node encodes U512.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Motes().String())
+}
+
+// UnmarshalJSON decodes the amount from the number of motes or the string accepted by ParseAmount.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var source string
+	if err := json.Unmarshal(data, &source); err != nil {
+		var number json.Number
+		if err = json.Unmarshal(data, &number); err != nil {
+			return fmt.Errorf("%w, details: %s", ErrInvalidAmount, err.Error())
+		}
+		source = number.String()
+	}
+	amount, err := ParseAmount(source)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// Value stores the amount as the decimal string of motes, which fits the NUMERIC and the text columns.
+func (a Amount) Value() (driver.Value, error) {
+	return a.Motes().String(), nil
+}
+
+// Scan reads the amount stored by Value, the NUMERIC, text and integer columns are supported. NULL is scanned as 0 motes,
+// use sql.Null[Amount] to tell NULL from zero. The float columns are rejected, they can't hold the big amounts exactly.
+func (a *Amount) Scan(value any) error {
+	var source string
+	switch data := value.(type) {
+	case nil:
+		*a = Amount{}
+		return nil
+	case []byte:
+		source = string(data)
+	case string:
+		source = data
+	case int64:
+		if data < 0 {
+			return fmt.Errorf("%w, motes: %d", ErrAmountOverflow, data)
+		}
+		*a = NewAmountFromMotes(uint64(data))
+		return nil
+	case float64:
+		return fmt.Errorf("%w, details: the float %v can't be scanned exactly, store the amount as NUMERIC or text", ErrInvalidAmount, data)
+	default:
+		return fmt.Errorf("%w, details: invalid scan value type %T", ErrInvalidAmount, value)
+	}
+	amount, err := ParseAmount(source)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// Amount returns the amount of the motes.
+func (m Motes) Amount() Amount {
+	return NewAmountFromMotes(uint64(m))
+}
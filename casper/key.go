@@ -17,6 +17,8 @@ type (
 	PublicKey           = keypair.PublicKey
 	PublicKeyList       = keypair.PublicKeyList
 	PrivateKey          = keypair.PrivateKey
+	Signer              = keypair.Signer
+	RemoteSigner        = keypair.RemoteSigner
 )
 
 var (
@@ -32,4 +34,5 @@ var (
 	NewPublicKey                      = keypair.NewPublicKey
 	NewED25519PrivateKeyFromPEMFile   = keypair.NewPrivateKeyED25518
 	NewSECP256k1PrivateKeyFromPEMFile = keypair.NewPrivateKeySECP256K1
+	NewRemoteSigner                   = keypair.NewRemoteSigner
 )
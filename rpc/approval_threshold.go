@@ -0,0 +1,59 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/make-software/casper-go-sdk/v2/types"
+)
+
+var ErrEmptyInitiator = errors.New("transaction initiator is empty")
+
+// CheckApprovalThresholds fetches the latest state of the transaction initiator, an addressable entity or a legacy account,
+// and checks whether the approvals of the transaction meet its action thresholds.
+// The entity is requested from the 2.x nodes and the account is requested with GetAccountInfo from the 1.x nodes,
+// so the client has to implement NodeVersionProvider, as the client built with NewClient does.
+func CheckApprovalThresholds(ctx context.Context, client Client, transaction types.Transaction) (types.ApprovalThresholds, error) {
+	initiator := transaction.InitiatorAddr
+	if initiator.PublicKey == nil && initiator.AccountHash == nil {
+		return types.ApprovalThresholds{}, ErrEmptyInitiator
+	}
+
+	versionProvider, ok := client.(NodeVersionProvider)
+	if !ok {
+		return types.ApprovalThresholds{}, fmt.Errorf("%w, details: the client doesn't implement NodeVersionProvider", ErrNodeVersionUnavailable)
+	}
+	version, err := versionProvider.GetNodeVersion(ctx)
+	if err != nil {
+		return types.ApprovalThresholds{}, err
+	}
+
+	if !version.IsV2() {
+		account, err := client.GetAccountInfo(ctx, nil, AccountIdentifier{
+			PublicKey:   initiator.PublicKey,
+			AccountHash: initiator.AccountHash,
+		})
+		if err != nil {
+			return types.ApprovalThresholds{}, err
+		}
+		return account.Account.CheckApprovals(transaction.Hash, transaction.Approvals), nil
+	}
+
+	result, err := client.GetLatestEntity(ctx, EntityIdentifier{
+		PublicKey:   initiator.PublicKey,
+		AccountHash: initiator.AccountHash,
+	})
+	if err != nil {
+		return types.ApprovalThresholds{}, err
+	}
+
+	switch {
+	case result.Entity.AddressableEntity != nil:
+		return result.Entity.AddressableEntity.Entity.CheckApprovals(transaction.Hash, transaction.Approvals), nil
+	case result.Entity.LegacyAccount != nil:
+		return result.Entity.LegacyAccount.CheckApprovals(transaction.Hash, transaction.Approvals), nil
+	default:
+		return types.ApprovalThresholds{}, ErrEmptyInitiator
+	}
+}
@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+var ErrUnsupportedClient = errors.New("client is not created by rpc.NewClient")
+
+// handlerProvider is implemented by the clients that expose the underlying Handler.
+type handlerProvider interface {
+	getHandler() Handler
+}
+
+// rawJSONSetter is implemented by the results that keep the raw JSON of RpcResponse.Result.
+type rawJSONSetter interface {
+	setRawJSON(data json.RawMessage)
+}
+
+// Call invokes an arbitrary RPC method through the Handler of the client and unmarshals the result to T.
+// It allows calling methods that don't have a dedicated wrapper in the Client, e.g. new node endpoints.
+// The request ID is propagated from the context as for the other Client methods, an RPC error is returned as *RpcError.
+// If T is one of the result types of the package or RawResult, the raw JSON of the result is preserved.
+func Call[T any](ctx context.Context, client Client, method Method, params any) (T, error) {
+	var result T
+	provider, ok := client.(handlerProvider)
+	if !ok {
+		return result, ErrUnsupportedClient
+	}
+
+	return CallWithHandler[T](ctx, provider.getHandler(), method, params)
+}
+
+// CallWithHandler invokes an arbitrary RPC method directly through the Handler and unmarshals the result to T.
+func CallWithHandler[T any](ctx context.Context, handler Handler, method Method, params any) (T, error) {
+	var result T
+	resp, err := processRequest(ctx, handler, method, params, &result)
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+
+	if setter, ok := any(&result).(rawJSONSetter); ok {
+		setter.setRawJSON(resp.Result)
+	}
+	return result, nil
+}
+
+// RawResult wraps a custom result type and keeps the raw JSON of the RpcResponse.Result.
+type RawResult[T any] struct {
+	Value T
+
+	rawJSON json.RawMessage
+}
+
+func (r RawResult[T]) GetRawJSON() json.RawMessage {
+	return r.rawJSON
+}
+
+func (r *RawResult[T]) setRawJSON(data json.RawMessage) {
+	r.rawJSON = data
+}
+
+func (r *RawResult[T]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &r.Value)
+}
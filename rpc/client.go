@@ -17,13 +17,16 @@ import (
 // This information is necessary for users involved with node operations and validation.
 type ClientPOS interface {
 	// GetLatestAuctionInfo returns the types.ValidatorBid and types.EraValidators from the most recent Block.
-	// RPC: state_get_auction_info_v2 with fallback on state_get_auction_info
+	// RPC: state_get_auction_info_v2 or state_get_auction_info depending on the NodeVersion,
+	// with fallback on state_get_auction_info if the version can't be resolved
 	GetLatestAuctionInfo(ctx context.Context) (StateGetAuctionInfoResult, error)
 	// GetAuctionInfoByHash returns the types.ValidatorBid and types.EraValidators of either a specific Block by hash
-	// RPC: state_get_auction_info_v2 with fallback on state_get_auction_info
+	// RPC: state_get_auction_info_v2 or state_get_auction_info depending on the NodeVersion,
+	// with fallback on state_get_auction_info if the version can't be resolved
 	GetAuctionInfoByHash(ctx context.Context, blockHash string) (StateGetAuctionInfoResult, error)
 	// GetAuctionInfoByHeight returns the types.ValidatorBid and types.EraValidators of either a specific Block by height
-	// RPC: state_get_auction_info_v2 with fallback on state_get_auction_info
+	// RPC: state_get_auction_info_v2 or state_get_auction_info depending on the NodeVersion,
+	// with fallback on state_get_auction_info if the version can't be resolved
 	GetAuctionInfoByHeight(ctx context.Context, height uint64) (StateGetAuctionInfoResult, error)
 	// GetLatestAuctionInfoV1 returns the types.ValidatorBid and types.EraValidators from the most recent Block.
 	// RPC: state_get_auction_info
@@ -152,6 +155,7 @@ type ClientInformational interface {
 
 	// GetStatus return the current status of a node on a Casper network.
 	// The responses return information specific to the queried node, and as such, will vary.
+	// The received versions of the node refresh the cached NodeVersion.
 	GetStatus(ctx context.Context) (InfoGetStatusResult, error)
 	// GetPeers return a list of peers connected to the node on a Casper network.
 	// The responses return information specific to the queried node, and as such, will vary.
@@ -210,6 +214,13 @@ type Client interface {
 	ClientTransactional
 }
 
+// Discoverer is implemented by the clients that request the OpenRPC schema of the node, the client built with NewClient does.
+// It is kept apart from the Client interface, so the other implementations of Client don't have to provide it.
+type Discoverer interface {
+	// Discover returns the OpenRPC schema of the RPC API exposed by the node.
+	Discover(ctx context.Context) (RpcDiscoverResult, error)
+}
+
 // Handler is responsible to implement interaction with underlying protocol.
 type Handler interface {
 	ProcessCall(ctx context.Context, params RpcRequest) (RpcResponse, error)
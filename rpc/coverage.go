@@ -0,0 +1,251 @@
+package rpc
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// clientMethodResults maps the RPC methods implemented by the SDK clients to the data structures
+// used to decode their results. The structures with the custom decoding are replaced by the ones describing
+// the actual shape of the decoded JSON.
+var clientMethodResults = map[Method]reflect.Type{
+	MethodGetDeploy:           reflect.TypeOf(InfoGetDeployResult{}),
+	MethodGetTransaction:      reflect.TypeOf(InfoGetTransactionResult{}),
+	MethodGetStateItem:        reflect.TypeOf(StateGetItemResult{}),
+	MethodQueryGlobalState:    reflect.TypeOf(QueryGlobalStateResult{}),
+	MethodGetDictionaryItem:   reflect.TypeOf(StateGetDictionaryResult{}),
+	MethodGetStateBalance:     reflect.TypeOf(StateGetBalanceResult{}),
+	MethodGetStateAccount:     reflect.TypeOf(StateGetAccountInfo{}),
+	MethodGetStatePackage:     reflect.TypeOf(StateGetPackage{}),
+	MethodGetStateEntity:      reflect.TypeOf(StateGetEntityResult{}),
+	MethodGetEraInfo:          reflect.TypeOf(ChainGetEraInfoResult{}),
+	MethodGetBlock:            reflect.TypeOf(chainGetBlockResultV1Compatible{}),
+	MethodGetBlockTransfers:   reflect.TypeOf(ChainGetBlockTransfersResult{}),
+	MethodGetEraSummary:       reflect.TypeOf(ChainGetEraSummaryResult{}),
+	MethodGetAuctionInfo:      reflect.TypeOf(StateGetAuctionInfoV1Result{}),
+	MethodGetAuctionInfoV2:    reflect.TypeOf(StateGetAuctionInfoV2Result{}),
+	MethodGetValidatorChanges: reflect.TypeOf(InfoGetValidatorChangesResult{}),
+	MethodGetStateRootHash:    reflect.TypeOf(ChainGetStateRootHashResult{}),
+	MethodGetStatus:           reflect.TypeOf(InfoGetStatusResult{}),
+	MethodGetReward:           reflect.TypeOf(InfoGetRewardResult{}),
+	MethodGetPeers:            reflect.TypeOf(InfoGetPeerResult{}),
+	MethodPutDeploy:           reflect.TypeOf(PutDeployResult{}),
+	MethodPutTransaction:      reflect.TypeOf(PutTransactionResult{}),
+	MethodSpeculativeExec:     reflect.TypeOf(SpeculativeExecResult{}),
+	MethodQueryBalance:        reflect.TypeOf(QueryBalanceResult{}),
+	MethodQueryBalanceDetails: reflect.TypeOf(QueryBalanceDetailsResult{}),
+	MethodInfoGetChainspec:    reflect.TypeOf(InfoGetChainspecResult{}),
+	MethodDiscover:            reflect.TypeOf(RpcDiscoverResult{}),
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	jsonRawMessageType  = reflect.TypeOf(json.RawMessage{})
+)
+
+// CoverageReport describes the difference between the RPC API discovered from the node and the SDK implementation.
+type CoverageReport struct {
+	// UncoveredMethods are exposed by the node, but not implemented by the SDK.
+	UncoveredMethods []string
+	// UnavailableMethods are implemented by the SDK, but not exposed by the node.
+	UnavailableMethods []Method
+	// DroppedFields are the result fields described by the node that are silently dropped by the SDK decoding.
+	DroppedFields []DroppedField
+}
+
+// IsFull returns true if the SDK implements all the methods of the node and decodes all fields of their results.
+func (r CoverageReport) IsFull() bool {
+	return len(r.UncoveredMethods) == 0 && len(r.DroppedFields) == 0
+}
+
+// DroppedField is the property of the result schema that doesn't have the corresponding field in the SDK structure.
+type DroppedField struct {
+	Method Method
+	// Path is the dot separated path to the property from the root of the result, "[]" denotes a list item.
+	Path string
+}
+
+func (f DroppedField) String() string {
+	return string(f.Method) + ": " + f.Path
+}
+
+// VerifyCoverage compares the methods and result schemas of the OpenRPC document with the methods implemented
+// by the SDK and the fields decoded by their result structures.
+// Values decoded with the custom json.Unmarshaler implementations are treated as opaque and aren't inspected.
+func VerifyCoverage(document OpenRpcDocument) CoverageReport {
+	var report CoverageReport
+	exposed := make(map[Method]bool, len(document.Methods))
+	for _, method := range document.Methods {
+		name := Method(method.Name)
+		exposed[name] = true
+		resultType, ok := clientMethodResults[name]
+		if !ok {
+			report.UncoveredMethods = append(report.UncoveredMethods, method.Name)
+			continue
+		}
+		if method.Result == nil {
+			continue
+		}
+		walker := coverageWalker{
+			document: document,
+			method:   name,
+			visited:  make(map[string]bool),
+		}
+		walker.walk(method.Result.Schema, resultType, "", true)
+		report.DroppedFields = append(report.DroppedFields, walker.dropped...)
+	}
+
+	for method := range clientMethodResults {
+		if !exposed[method] {
+			report.UnavailableMethods = append(report.UnavailableMethods, method)
+		}
+	}
+
+	sort.Strings(report.UncoveredMethods)
+	sort.Slice(report.UnavailableMethods, func(i, j int) bool {
+		return report.UnavailableMethods[i] < report.UnavailableMethods[j]
+	})
+	sort.Slice(report.DroppedFields, func(i, j int) bool {
+		if report.DroppedFields[i].Method != report.DroppedFields[j].Method {
+			return report.DroppedFields[i].Method < report.DroppedFields[j].Method
+		}
+		return report.DroppedFields[i].Path < report.DroppedFields[j].Path
+	})
+	report.DroppedFields = uniqueDroppedFields(report.DroppedFields)
+	return report
+}
+
+type coverageWalker struct {
+	document OpenRpcDocument
+	method   Method
+	visited  map[string]bool
+	dropped  []DroppedField
+}
+
+func (w *coverageWalker) walk(schema *JSONSchema, goType reflect.Type, path string, isRoot bool) {
+	if schema == nil {
+		return
+	}
+	if schema.Ref != "" {
+		visitKey := schema.Ref + "|" + goType.String()
+		if w.visited[visitKey] {
+			return
+		}
+		w.visited[visitKey] = true
+		schema = w.document.ResolveSchema(schema)
+		if schema == nil {
+			return
+		}
+	}
+
+	for goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+	if goType.Kind() == reflect.Interface || goType == jsonRawMessageType {
+		return
+	}
+	if !isRoot && reflect.PointerTo(goType).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	for _, group := range [][]*JSONSchema{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, sub := range group {
+			w.walk(sub, goType, path, isRoot)
+		}
+	}
+
+	switch goType.Kind() {
+	case reflect.Struct:
+		if len(schema.Properties) == 0 {
+			return
+		}
+		fields := jsonFieldsOf(goType)
+		names := make([]string, 0, len(schema.Properties))
+		for name := range schema.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fieldPath := joinCoveragePath(path, name)
+			fieldType, ok := lookupJSONField(fields, name)
+			if !ok {
+				w.dropped = append(w.dropped, DroppedField{Method: w.method, Path: fieldPath})
+				continue
+			}
+			w.walk(schema.Properties[name], fieldType, fieldPath, false)
+		}
+	case reflect.Slice, reflect.Array:
+		w.walk(schema.Items, goType.Elem(), path+"[]", false)
+	case reflect.Map:
+		w.walk(schema.AdditionalPropertiesSchema(), goType.Elem(), path+"[]", false)
+	}
+}
+
+// uniqueDroppedFields removes the duplicates from the sorted list, the same property can be reached through several schema alternatives.
+func uniqueDroppedFields(fields []DroppedField) []DroppedField {
+	var result []DroppedField
+	for _, field := range fields {
+		if len(result) > 0 && result[len(result)-1] == field {
+			continue
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+func joinCoveragePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// jsonFieldsOf collects the JSON names of the fields decoded by encoding/json, including promoted fields of embedded structs.
+func jsonFieldsOf(goType reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < goType.NumField(); i++ {
+		field := goType.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for embeddedName, embeddedType := range jsonFieldsOf(embedded) {
+					if _, ok := fields[embeddedName]; !ok {
+						fields[embeddedName] = embeddedType
+					}
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// lookupJSONField matches the name the same way as encoding/json does, preferring an exact match.
+func lookupJSONField(fields map[string]reflect.Type, name string) (reflect.Type, bool) {
+	if fieldType, ok := fields[name]; ok {
+		return fieldType, true
+	}
+	for fieldName, fieldType := range fields {
+		if strings.EqualFold(fieldName, name) {
+			return fieldType, true
+		}
+	}
+	return nil, false
+}
@@ -16,6 +16,7 @@ var (
 	ErrProcessHttpRequest      = errors.New("failed to sent http request")
 	ErrReadHttpResponseBody    = errors.New("failed to read http response body")
 	ErrRpcResponseUnmarshal    = errors.New("failed to unmarshal rpc response")
+	ErrResponseTooLarge        = errors.New("rpc response exceeds the maximum size")
 )
 
 // HttpHandler implements Handler interface using the HTTP protocol under the implementation
@@ -23,6 +24,16 @@ type HttpHandler struct {
 	httpClient    *http.Client
 	endpoint      string
 	CustomHeaders map[string]string
+	// MaxResponseSize limits the size of the response body in bytes, zero means no limit.
+	MaxResponseSize int64
+	// Streaming enables decoding of the result directly from the response body into the target data structure,
+	// so only the JSON of the result is buffered by the decoder instead of the whole body, and the result is decoded once.
+	// The raw JSON of the result is not kept in the streaming mode unless KeepRawJSON is set,
+	// GetRawJSON of the results returns nil in this case.
+	Streaming bool
+	// KeepRawJSON keeps the raw JSON of the result in the streaming mode, it is copied aside while the result is decoded,
+	// which takes about as much memory as the JSON of the result.
+	KeepRawJSON bool
 }
 
 // NewHttpHandler is a constructor for HttpHandler that suppose to configure http.Client
@@ -38,14 +49,62 @@ func NewHttpHandler(endpoint string, client *http.Client) *HttpHandler {
 // reads a response and handles errors. All logic with HTTP interaction is isolated here and can be replaced with
 // other (more efficient) protocols.
 func (c *HttpHandler) ProcessCall(ctx context.Context, params RpcRequest) (RpcResponse, error) {
+	body, err := c.sendRequest(ctx, params)
+	if err != nil {
+		return RpcResponse{}, err
+	}
+
+	var rpcResponse RpcResponse
+	defer body.Close()
+	b, err := io.ReadAll(body)
+	if err != nil {
+		if errors.Is(err, ErrResponseTooLarge) {
+			return RpcResponse{}, err
+		}
+		return RpcResponse{}, fmt.Errorf("%w, details: %s", ErrReadHttpResponseBody, err.Error())
+	}
+
+	err = json.Unmarshal(b, &rpcResponse)
+	if err != nil {
+		return RpcResponse{}, fmt.Errorf("%w, details: %s", ErrRpcResponseUnmarshal, err.Error())
+	}
+
+	return rpcResponse, nil
+}
+
+// ProcessCallStream implements StreamHandler. In the streaming mode the result is decoded directly from the response body,
+// otherwise the call is processed with ProcessCall and the result is unmarshalled from the buffered response.
+func (c *HttpHandler) ProcessCallStream(ctx context.Context, params RpcRequest, result any) (RpcResponse, error) {
+	if !c.Streaming {
+		resp, err := c.ProcessCall(ctx, params)
+		if err != nil || resp.Error != nil {
+			return resp, err
+		}
+		if err = json.Unmarshal(resp.Result, result); err != nil {
+			return resp, fmt.Errorf("%w, details: %s", ErrResultUnmarshal, err.Error())
+		}
+		return resp, nil
+	}
+
+	body, err := c.sendRequest(ctx, params)
+	if err != nil {
+		return RpcResponse{}, err
+	}
+	defer body.Close()
+
+	return decodeRpcResponseStream(body, result, c.KeepRawJSON)
+}
+
+// sendRequest sends the request and returns the body of the successful response limited by MaxResponseSize.
+func (c *HttpHandler) sendRequest(ctx context.Context, params RpcRequest) (io.ReadCloser, error) {
 	body, err := json.Marshal(params)
 	if err != nil {
-		return RpcResponse{}, fmt.Errorf("%w, details: %s", ErrParamsUnmarshalHandler, err.Error())
+		return nil, fmt.Errorf("%w, details: %s", ErrParamsUnmarshalHandler, err.Error())
 	}
 
 	request, err := http.NewRequest(http.MethodPost, c.endpoint, bytes.NewReader(body))
 	if err != nil {
-		return RpcResponse{}, fmt.Errorf("%w, details: %s", ErrBuildHttpRequestHandler, err.Error())
+		return nil, fmt.Errorf("%w, details: %s", ErrBuildHttpRequestHandler, err.Error())
 	}
 	request.Header.Add("Content-Type", "application/json")
 	for name, val := range c.CustomHeaders {
@@ -55,27 +114,40 @@ func (c *HttpHandler) ProcessCall(ctx context.Context, params RpcRequest) (RpcRe
 
 	resp, err := c.httpClient.Do(request)
 	if err != nil {
-		return RpcResponse{}, fmt.Errorf("%w, details: %s", ErrProcessHttpRequest, err.Error())
+		return nil, fmt.Errorf("%w, details: %s", ErrProcessHttpRequest, err.Error())
 	}
 
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return RpcResponse{}, fmt.Errorf("http error from rpc, %w", &HttpError{
+		resp.Body.Close()
+		return nil, fmt.Errorf("http error from rpc, %w", &HttpError{
 			SourceErr:  errors.New(resp.Status),
 			StatusCode: resp.StatusCode,
 		})
 	}
 
-	var rpcResponse RpcResponse
-	defer resp.Body.Close()
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return RpcResponse{}, fmt.Errorf("%w, details: %s", ErrReadHttpResponseBody, err.Error())
+	if c.MaxResponseSize > 0 {
+		return &limitedReadCloser{ReadCloser: resp.Body, remaining: c.MaxResponseSize}, nil
 	}
+	return resp.Body, nil
+}
 
-	err = json.Unmarshal(b, &rpcResponse)
-	if err != nil {
-		return RpcResponse{}, fmt.Errorf("%w, details: %s", ErrRpcResponseUnmarshal, err.Error())
-	}
+// limitedReadCloser fails with ErrResponseTooLarge when the body exceeds the limit instead of truncating it silently.
+type limitedReadCloser struct {
+	io.ReadCloser
+	remaining int64
+}
 
-	return rpcResponse, nil
+func (l *limitedReadCloser) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, ErrResponseTooLarge
+	}
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.ReadCloser.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, ErrResponseTooLarge
+	}
+	return n, err
 }
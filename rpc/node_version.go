@@ -0,0 +1,175 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultVersionRefreshInterval is the period after which the cached NodeVersion is resolved again.
+var DefaultVersionRefreshInterval = 10 * time.Minute
+
+var (
+	ErrMalformedNodeVersion   = errors.New("malformed node api version")
+	ErrNodeVersionUnavailable = errors.New("failed to resolve node version")
+)
+
+// rpcMethodNotFoundCode is the JSON-RPC error code returned by the nodes that don't implement the requested method.
+const rpcMethodNotFoundCode = -32601
+
+// NodeVersionProvider is implemented by the clients that resolve the NodeVersion of the node, the client built with NewClient does.
+// It is kept apart from the Client interface, so the other implementations of Client don't have to provide it.
+type NodeVersionProvider interface {
+	// GetNodeVersion returns the NodeVersion used by the client to choose the RPC methods.
+	// The version is resolved with info_get_status once and cached according to the refresh interval, unless it is pinned.
+	// The failed resolution is cached for the refresh interval too.
+	// The error wraps ErrNodeVersionUnavailable if info_get_status fails and ErrMalformedNodeVersion if the api_version is malformed.
+	GetNodeVersion(ctx context.Context) (NodeVersion, error)
+}
+
+// NodeVersion contains the versions reported by the node in the info_get_status response.
+// The client uses it to choose the RPC methods, the results are decoded by their shape regardless of the version.
+type NodeVersion struct {
+	// The RPC API version.
+	ApiVersion string `json:"api_version"`
+	// The compiled node version.
+	BuildVersion string `json:"build_version"`
+}
+
+// NewNodeVersion is a constructor for NodeVersion with the RPC API version only, it is used for pinning the version.
+func NewNodeVersion(apiVersion string) NodeVersion {
+	return NodeVersion{ApiVersion: apiVersion}
+}
+
+// Major returns the major part of the RPC API version, the empty or malformed version is rejected with ErrMalformedNodeVersion.
+func (v NodeVersion) Major() (int, error) {
+	major, _, _ := strings.Cut(strings.TrimPrefix(v.ApiVersion, "v"), ".")
+	value, err := strconv.Atoi(major)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("%w, api version: %q", ErrMalformedNodeVersion, v.ApiVersion)
+	}
+	return value, nil
+}
+
+// IsV2 returns true if the node implements the Casper 2.x RPC API, false for the malformed version.
+// GetNodeVersion never returns the malformed version, check Major for the versions built otherwise.
+func (v NodeVersion) IsV2() bool {
+	major, err := v.Major()
+	return err == nil && major >= 2
+}
+
+// ClientOption configures the client built with NewClient.
+type ClientOption func(c *client)
+
+// WithNodeVersion pins the NodeVersion, so the client never requests it from the node.
+func WithNodeVersion(version NodeVersion) ClientOption {
+	return func(c *client) {
+		c.versions.pinned = &version
+	}
+}
+
+// WithVersionRefreshInterval configures how long the resolved NodeVersion is cached,
+// zero interval keeps the version for the client lifetime.
+func WithVersionRefreshInterval(interval time.Duration) ClientOption {
+	return func(c *client) {
+		c.versions.refreshInterval = interval
+	}
+}
+
+// nodeVersionCache keeps the NodeVersion resolved from the node or pinned by the caller.
+// The failed resolution is cached as well, so a node without info_get_status isn't asked for it on every call.
+type nodeVersionCache struct {
+	mu              sync.Mutex
+	pinned          *NodeVersion
+	resolved        *NodeVersion
+	resolvedAt      time.Time
+	failure         error
+	failedAt        time.Time
+	refreshInterval time.Duration
+	// resolving shares the single resolution between the concurrent callers
+	resolving singleflight.Group
+}
+
+func newNodeVersionCache() *nodeVersionCache {
+	return &nodeVersionCache{refreshInterval: DefaultVersionRefreshInterval}
+}
+
+// get returns the cached version or resolves it with the resolve function if the cache is empty or expired.
+// The concurrent callers wait for the same resolution, each of them returns as soon as its context is done.
+func (n *nodeVersionCache) get(ctx context.Context, resolve func(ctx context.Context) (NodeVersion, error)) (NodeVersion, error) {
+	if n.pinned != nil {
+		if _, err := n.pinned.Major(); err != nil {
+			return NodeVersion{}, err
+		}
+		return *n.pinned, nil
+	}
+	if version, ok, err := n.cached(); ok {
+		return version, err
+	}
+	if err := ctx.Err(); err != nil {
+		return NodeVersion{}, err
+	}
+
+	call := n.resolving.DoChan("", func() (any, error) {
+		version, err := resolve(ctx)
+		if err == nil {
+			_, err = version.Major()
+		}
+		if err == nil {
+			n.store(version)
+			return version, nil
+		}
+		// the failure caused by the cancelled caller says nothing about the node
+		if ctx.Err() == nil {
+			n.mu.Lock()
+			n.failure, n.failedAt = err, time.Now()
+			n.mu.Unlock()
+		}
+		return NodeVersion{}, err
+	})
+	select {
+	case result := <-call:
+		if result.Err != nil {
+			return NodeVersion{}, result.Err
+		}
+		return result.Val.(NodeVersion), nil
+	case <-ctx.Done():
+		return NodeVersion{}, ctx.Err()
+	}
+}
+
+// cached returns the version or the failure of the last resolution unless they are expired.
+// The failure is kept for the refresh interval, or for DefaultVersionRefreshInterval if the interval is zero.
+func (n *nodeVersionCache) cached() (NodeVersion, bool, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.resolved != nil && (n.refreshInterval == 0 || time.Since(n.resolvedAt) < n.refreshInterval) {
+		return *n.resolved, true, nil
+	}
+	failureInterval := n.refreshInterval
+	if failureInterval == 0 {
+		failureInterval = DefaultVersionRefreshInterval
+	}
+	if n.failure != nil && time.Since(n.failedAt) < failureInterval {
+		return NodeVersion{}, true, n.failure
+	}
+	return NodeVersion{}, false, nil
+}
+
+// store refreshes the cache with the version received from the node, the malformed version is not kept.
+func (n *nodeVersionCache) store(version NodeVersion) {
+	if _, err := version.Major(); err != nil {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.resolved = &version
+	n.resolvedAt = time.Now()
+	n.failure = nil
+}
@@ -0,0 +1,137 @@
+package rpc
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const openRpcSchemaRefPrefix = "#/components/schemas/"
+
+// OpenRpcDocument is the OpenRPC schema returned by the rpc.discover method.
+// See the [specification](https://spec.open-rpc.org/) for details.
+type OpenRpcDocument struct {
+	OpenRPC    string            `json:"openrpc"`
+	Info       OpenRpcInfo       `json:"info"`
+	Servers    []OpenRpcServer   `json:"servers,omitempty"`
+	Methods    []OpenRpcMethod   `json:"methods"`
+	Components OpenRpcComponents `json:"components"`
+}
+
+// Method returns the description of the method by name.
+func (d OpenRpcDocument) Method(name Method) (OpenRpcMethod, bool) {
+	for _, one := range d.Methods {
+		if one.Name == string(name) {
+			return one, true
+		}
+	}
+	return OpenRpcMethod{}, false
+}
+
+// ResolveSchema returns the schema referenced from the components section, or the schema itself if it is not a reference.
+func (d OpenRpcDocument) ResolveSchema(schema *JSONSchema) *JSONSchema {
+	for schema != nil && schema.Ref != "" {
+		resolved, ok := d.Components.Schemas[strings.TrimPrefix(schema.Ref, openRpcSchemaRefPrefix)]
+		if !ok {
+			return nil
+		}
+		schema = resolved
+	}
+	return schema
+}
+
+type OpenRpcInfo struct {
+	Version     string          `json:"version"`
+	Title       string          `json:"title"`
+	Description string          `json:"description,omitempty"`
+	Contact     json.RawMessage `json:"contact,omitempty"`
+	License     json.RawMessage `json:"license,omitempty"`
+}
+
+type OpenRpcServer struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type OpenRpcMethod struct {
+	Name     string                     `json:"name"`
+	Summary  string                     `json:"summary,omitempty"`
+	Params   []OpenRpcContentDescriptor `json:"params"`
+	Result   *OpenRpcContentDescriptor  `json:"result,omitempty"`
+	Examples json.RawMessage            `json:"examples,omitempty"`
+}
+
+// OpenRpcContentDescriptor describes a param or a result of the method.
+type OpenRpcContentDescriptor struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Required    bool        `json:"required,omitempty"`
+	Schema      *JSONSchema `json:"schema"`
+}
+
+type OpenRpcComponents struct {
+	Schemas map[string]*JSONSchema `json:"schemas"`
+}
+
+// JSONSchema is the subset of the JSON Schema used by the node to describe params and results.
+type JSONSchema struct {
+	Ref         string                 `json:"$ref,omitempty"`
+	Title       string                 `json:"title,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Type        JSONSchemaType         `json:"type,omitempty"`
+	Format      string                 `json:"format,omitempty"`
+	Properties  map[string]*JSONSchema `json:"properties,omitempty"`
+	Required    []string               `json:"required,omitempty"`
+	Items       *JSONSchema            `json:"items,omitempty"`
+	// AdditionalProperties is either a boolean or a schema of the values of the map
+	AdditionalProperties json.RawMessage   `json:"additionalProperties,omitempty"`
+	Enum                 []json.RawMessage `json:"enum,omitempty"`
+	AllOf                []*JSONSchema     `json:"allOf,omitempty"`
+	AnyOf                []*JSONSchema     `json:"anyOf,omitempty"`
+	OneOf                []*JSONSchema     `json:"oneOf,omitempty"`
+}
+
+// AdditionalPropertiesSchema returns the schema of the map values if it is declared.
+func (s JSONSchema) AdditionalPropertiesSchema() *JSONSchema {
+	if len(s.AdditionalProperties) == 0 {
+		return nil
+	}
+	var schema JSONSchema
+	if err := json.Unmarshal(s.AdditionalProperties, &schema); err != nil {
+		return nil
+	}
+	return &schema
+}
+
+// JSONSchemaType represents the "type" keyword which can be either a single type or a list of types.
+type JSONSchemaType []string
+
+func (t JSONSchemaType) Has(name string) bool {
+	for _, one := range t {
+		if one == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (t JSONSchemaType) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+func (t *JSONSchemaType) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = JSONSchemaType{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*t = list
+	return nil
+}
@@ -58,6 +58,7 @@ const (
 	MethodQueryBalance        Method = "query_balance"
 	MethodQueryBalanceDetails Method = "query_balance_details"
 	MethodInfoGetChainspec    Method = "info_get_chainspec"
+	MethodDiscover            Method = "rpc.discover"
 )
 
 // RpcRequest is a wrapper struct for an RPC call method that can be serialized to JSON.
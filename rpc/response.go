@@ -31,6 +31,26 @@ func (b StateGetAuctionInfoResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *StateGetAuctionInfoResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
+func newStateGetAuctionInfoResultFromV1(result StateGetAuctionInfoV1Result) StateGetAuctionInfoResult {
+	return StateGetAuctionInfoResult{
+		Version:      result.Version,
+		AuctionState: types.NewAuctionStateFromV1(result.AuctionState),
+		rawJSON:      result.GetRawJSON(),
+	}
+}
+
+func newStateGetAuctionInfoResultFromV2(result StateGetAuctionInfoV2Result) StateGetAuctionInfoResult {
+	return StateGetAuctionInfoResult{
+		Version:      result.Version,
+		AuctionState: types.NewAuctionStateFromV2(result.AuctionState),
+		rawJSON:      result.GetRawJSON(),
+	}
+}
+
 type StateGetAuctionInfoV1Result struct {
 	Version      string               `json:"api_version"`
 	AuctionState types.AuctionStateV1 `json:"auction_state"`
@@ -42,6 +62,10 @@ func (b StateGetAuctionInfoV1Result) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *StateGetAuctionInfoV1Result) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type StateGetAuctionInfoV2Result struct {
 	Version      string               `json:"api_version"`
 	AuctionState types.AuctionStateV2 `json:"auction_state"`
@@ -53,6 +77,10 @@ func (b StateGetAuctionInfoV2Result) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *StateGetAuctionInfoV2Result) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type StateGetBalanceResult struct {
 	ApiVersion   string          `json:"api_version"`
 	BalanceValue clvalue.UInt512 `json:"balance_value"`
@@ -64,6 +92,10 @@ func (b StateGetBalanceResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *StateGetBalanceResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type StateGetPackage struct {
 	ApiVersion string  `json:"api_version"`
 	Package    Package `json:"package"`
@@ -82,6 +114,10 @@ func (b StateGetPackage) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *StateGetPackage) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type StateGetAccountInfo struct {
 	ApiVersion string        `json:"api_version"`
 	Account    types.Account `json:"account"`
@@ -93,6 +129,10 @@ func (b StateGetAccountInfo) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *StateGetAccountInfo) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 // EntityOrAccount An addressable entity or a legacy account.
 type EntityOrAccount struct {
 	// An addressable entity.
@@ -129,13 +169,17 @@ func (b ChainGetBlockResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *ChainGetBlockResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 func (v *ChainGetBlockResult) UnmarshalJSON(data []byte) error {
 	var res chainGetBlockResultV1Compatible
 	if err := json.Unmarshal(data, &res); err != nil {
 		return err
 	}
 
-	blockResult, err := newChainGetBlockResultFromV1Compatible(res, data)
+	blockResult, err := newChainGetBlockResultFromV1Compatible(res)
 	if err != nil {
 		return err
 	}
@@ -150,12 +194,11 @@ type chainGetBlockResultV1Compatible struct {
 	BlockV1             *types.BlockV1             `json:"block"`
 }
 
-func newChainGetBlockResultFromV1Compatible(result chainGetBlockResultV1Compatible, rawJSON json.RawMessage) (ChainGetBlockResult, error) {
+func newChainGetBlockResultFromV1Compatible(result chainGetBlockResultV1Compatible) (ChainGetBlockResult, error) {
 	if result.BlockV1 != nil {
 		return ChainGetBlockResult{
 			APIVersion: result.APIVersion,
 			Block:      types.NewBlockFromBlockV1(*result.BlockV1),
-			rawJSON:    rawJSON,
 		}, nil
 	}
 
@@ -163,7 +206,6 @@ func newChainGetBlockResultFromV1Compatible(result chainGetBlockResultV1Compatib
 		return ChainGetBlockResult{
 			APIVersion: result.APIVersion,
 			Block:      types.NewBlockFromBlockWrapper(result.BlockWithSignatures.Block, result.BlockWithSignatures.Proofs),
-			rawJSON:    rawJSON,
 		}, nil
 	}
 	return ChainGetBlockResult{}, errors.New("incorrect RPC response structure")
@@ -181,6 +223,10 @@ func (b ChainGetBlockTransfersResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *ChainGetBlockTransfersResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type ChainGetEraSummaryResult struct {
 	Version    string           `json:"api_version"`
 	EraSummary types.EraSummary `json:"era_summary"`
@@ -192,6 +238,10 @@ func (b ChainGetEraSummaryResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *ChainGetEraSummaryResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type InfoGetDeployResult struct {
 	ApiVersion       string                    `json:"api_version"`
 	Deploy           types.Deploy              `json:"deploy"`
@@ -225,6 +275,10 @@ func (v *InfoGetDeployResult) GetRawJSON() json.RawMessage {
 	return v.rawJSON
 }
 
+func (v *InfoGetDeployResult) setRawJSON(data json.RawMessage) {
+	v.rawJSON = data
+}
+
 func (v *InfoGetDeployResult) UnmarshalJSON(data []byte) error {
 	version := struct {
 		ApiVersion string `json:"api_version"`
@@ -243,7 +297,6 @@ func (v *InfoGetDeployResult) UnmarshalJSON(data []byte) error {
 			ApiVersion:       v1Compatible.ApiVersion,
 			Deploy:           v1Compatible.Deploy,
 			ExecutionResults: types.DeployExecutionInfoFromV1(v1Compatible.ExecutionResults, v1Compatible.BlockHeight),
-			rawJSON:          data,
 		}
 		return nil
 	}
@@ -258,7 +311,6 @@ func (v *InfoGetDeployResult) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	resp.rawJSON = data
 	*v = resp
 	return nil
 }
@@ -288,6 +340,10 @@ func (b InfoGetTransactionResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *InfoGetTransactionResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type infoGetTransactionResultV1Compatible struct {
 	APIVersion       string                        `json:"api_version"`
 	Transaction      *types.TransactionWrapper     `json:"transaction"`
@@ -298,14 +354,13 @@ type infoGetTransactionResultV1Compatible struct {
 	BlockHeight      *uint64                       `json:"block_height,omitempty"`
 }
 
-func newInfoGetTransactionResultFromV1Compatible(result infoGetTransactionResultV1Compatible, rawJSON json.RawMessage) (InfoGetTransactionResult, error) {
+func newInfoGetTransactionResultFromV1Compatible(result infoGetTransactionResultV1Compatible) (InfoGetTransactionResult, error) {
 	if result.Transaction != nil {
 		if result.Transaction.TransactionV1 != nil {
 			return InfoGetTransactionResult{
 				APIVersion:    result.APIVersion,
 				Transaction:   types.NewTransactionFromTransactionV1(*result.Transaction.TransactionV1),
 				ExecutionInfo: result.ExecutionInfo,
-				rawJSON:       rawJSON,
 			}, nil
 		}
 
@@ -314,7 +369,6 @@ func newInfoGetTransactionResultFromV1Compatible(result infoGetTransactionResult
 				APIVersion:    result.APIVersion,
 				Transaction:   types.NewTransactionFromDeploy(*result.Transaction.Deploy),
 				ExecutionInfo: result.ExecutionInfo,
-				rawJSON:       rawJSON,
 			}
 
 			if len(result.ExecutionResults) > 0 {
@@ -335,7 +389,6 @@ func newInfoGetTransactionResultFromV1Compatible(result infoGetTransactionResult
 			APIVersion:    result.APIVersion,
 			Transaction:   types.NewTransactionFromDeploy(*result.Deploy),
 			ExecutionInfo: result.ExecutionInfo,
-			rawJSON:       rawJSON,
 		}
 
 		if len(result.ExecutionResults) > 0 {
@@ -363,6 +416,10 @@ func (b ChainGetEraInfoResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *ChainGetEraInfoResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type StateGetItemResult struct {
 	StoredValue types.StoredValue `json:"stored_value"`
 	//MerkleProof is a construction created using a merkle trie that allows verification of the associated hashes.
@@ -375,6 +432,10 @@ func (b StateGetItemResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *StateGetItemResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type StateGetDictionaryResult struct {
 	ApiVersion    string            `json:"api_version"`
 	DictionaryKey string            `json:"dictionary_key"`
@@ -388,6 +449,10 @@ func (b StateGetDictionaryResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *StateGetDictionaryResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type QueryGlobalStateResult struct {
 	ApiVersion  string            `json:"api_version"`
 	BlockHeader types.BlockHeader `json:"block_header,omitempty"`
@@ -402,6 +467,10 @@ func (b QueryGlobalStateResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *QueryGlobalStateResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type InfoGetPeerResult struct {
 	ApiVersion string     `json:"api_version"`
 	Peers      []NodePeer `json:"peers"`
@@ -413,6 +482,10 @@ func (b InfoGetPeerResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *InfoGetPeerResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type NodePeer struct {
 	NodeID  string `json:"node_id"`
 	Address string `json:"address"`
@@ -429,6 +502,10 @@ func (b ChainGetStateRootHashResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *ChainGetStateRootHashResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type ValidatorState string
 
 const (
@@ -477,6 +554,10 @@ func (b InfoGetValidatorChangesResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *InfoGetValidatorChangesResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type InfoGetStatusResult struct {
 	// The RPC API version.
 	APIVersion string `json:"api_version"`
@@ -547,6 +628,10 @@ func (p PutDeployResult) GetRawJSON() json.RawMessage {
 	return p.rawJSON
 }
 
+func (p *PutDeployResult) setRawJSON(data json.RawMessage) {
+	p.rawJSON = data
+}
+
 type PutTransactionResult struct {
 	ApiVersion      string                `json:"api_version"`
 	TransactionHash types.TransactionHash `json:"transaction_hash"`
@@ -558,10 +643,18 @@ func (p PutTransactionResult) GetRawJSON() json.RawMessage {
 	return p.rawJSON
 }
 
+func (p *PutTransactionResult) setRawJSON(data json.RawMessage) {
+	p.rawJSON = data
+}
+
 func (b InfoGetStatusResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *InfoGetStatusResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type SpeculativeExecResult struct {
 	ApiVersion      string                `json:"api_version"`
 	BlockHash       key.Hash              `json:"block_hash"`
@@ -574,6 +667,10 @@ func (b SpeculativeExecResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *SpeculativeExecResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type QueryBalanceResult struct {
 	ApiVersion string          `json:"api_version"`
 	Balance    clvalue.UInt512 `json:"balance"`
@@ -584,6 +681,10 @@ func (b QueryBalanceResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *QueryBalanceResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type QueryBalanceDetailsResult struct {
 	APIVersion        string                 `json:"api_version"`
 	TotalBalance      clvalue.UInt512        `json:"total_balance"`
@@ -598,6 +699,10 @@ func (b QueryBalanceDetailsResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *QueryBalanceDetailsResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type InfoGetRewardResult struct {
 	APIVersion      string          `json:"api_version"`
 	DelegationRate  float32         `json:"delegation_rate"`
@@ -612,6 +717,10 @@ func (b InfoGetRewardResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *InfoGetRewardResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 // BalanceHoldWithProof The block time at which the hold was created.
 type BalanceHoldWithProof struct {
 	//Time   types.BlockTime `json:"time"`
@@ -633,6 +742,27 @@ func (b InfoGetChainspecResult) GetRawJSON() json.RawMessage {
 	return b.rawJSON
 }
 
+func (b *InfoGetChainspecResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
+type RpcDiscoverResult struct {
+	ApiVersion string `json:"api_version"`
+	Name       string `json:"name"`
+	// Schema is the OpenRPC document describing the RPC API of the node.
+	Schema OpenRpcDocument `json:"schema"`
+
+	rawJSON json.RawMessage
+}
+
+func (b RpcDiscoverResult) GetRawJSON() json.RawMessage {
+	return b.rawJSON
+}
+
+func (b *RpcDiscoverResult) setRawJSON(data json.RawMessage) {
+	b.rawJSON = data
+}
+
 type queryGlobalStateResultV1Compatible struct {
 	ApiVersion  string              `json:"api_version"`
 	BlockHeader types.BlockHeaderV1 `json:"block_header,omitempty"`
@@ -649,7 +779,7 @@ func (h *InfoGetTransactionResult) UnmarshalJSON(bytes []byte) error {
 		return err
 	}
 
-	result, err := newInfoGetTransactionResultFromV1Compatible(temp, bytes)
+	result, err := newInfoGetTransactionResultFromV1Compatible(temp)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/make-software/casper-go-sdk/v2/types"
 	"github.com/make-software/casper-go-sdk/v2/types/key"
@@ -19,13 +18,22 @@ var ErrResultUnmarshal = errors.New("failed to unmarshal rpc result")
 // and serializes RpcResponse to the corresponding data structures.
 // Most interaction work with RPC delegates to the Handler.
 type client struct {
-	handler Handler
+	handler  Handler
+	versions *nodeVersionCache
 }
 
 // NewClient is a constructor for client that suppose to configure Handler
 // examples of usage can be found here [Test_ConfigurableClient_GetDeploy]
-func NewClient(handler Handler) Client {
-	return &client{handler: handler}
+func NewClient(handler Handler, options ...ClientOption) Client {
+	c := &client{handler: handler, versions: newNodeVersionCache()}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+func (c *client) getHandler() Handler {
+	return c.handler
 }
 
 func (c *client) GetDeploy(ctx context.Context, hash string) (InfoGetDeployResult, error) {
@@ -63,7 +71,7 @@ func (c *client) GetTransactionByTransactionHash(ctx context.Context, transactio
 	}
 
 	var result InfoGetTransactionResult
-	_, err = c.processRequest(ctx, MethodGetTransaction, ParamTransactionHash{
+	resp, err := c.processRequest(ctx, MethodGetTransaction, ParamTransactionHash{
 		TransactionHash: types.TransactionHash{
 			TransactionV1: &hash,
 		},
@@ -72,6 +80,7 @@ func (c *client) GetTransactionByTransactionHash(ctx context.Context, transactio
 		return InfoGetTransactionResult{}, err
 	}
 
+	result.rawJSON = resp.Result
 	return result, nil
 }
 
@@ -81,8 +90,25 @@ func (c *client) GetTransactionByDeployHash(ctx context.Context, deployHash stri
 		return InfoGetTransactionResult{}, err
 	}
 
+	api, err := c.resolveNodeAPI(ctx)
+	if err != nil {
+		return InfoGetTransactionResult{}, err
+	}
+
 	var result InfoGetTransactionResult
-	_, err = c.processRequest(ctx, MethodGetTransaction, ParamTransactionHash{
+	if api == nodeAPIV1 {
+		// info_get_transaction is not available before 2.0, the deploy response is decoded with the V1 compatible logic
+		resp, err := c.processRequest(ctx, MethodGetDeploy, map[string]string{
+			"deploy_hash": hash.ToHex(),
+		}, &result)
+		if err != nil {
+			return InfoGetTransactionResult{}, err
+		}
+		result.rawJSON = resp.Result
+		return result, nil
+	}
+
+	resp, err := c.processRequest(ctx, MethodGetTransaction, ParamTransactionHash{
 		TransactionHash: types.TransactionHash{
 			Deploy: &hash,
 		},
@@ -91,6 +117,7 @@ func (c *client) GetTransactionByDeployHash(ctx context.Context, deployHash stri
 		return InfoGetTransactionResult{}, err
 	}
 
+	result.rawJSON = resp.Result
 	return result, nil
 }
 
@@ -101,7 +128,7 @@ func (c *client) GetTransactionFinalizedApprovalByTransactionHash(ctx context.Co
 	}
 
 	var result InfoGetTransactionResult
-	_, err = c.processRequest(ctx, MethodGetTransaction, ParamTransactionHash{
+	resp, err := c.processRequest(ctx, MethodGetTransaction, ParamTransactionHash{
 		TransactionHash: types.TransactionHash{
 			TransactionV1: &hash,
 		},
@@ -111,6 +138,7 @@ func (c *client) GetTransactionFinalizedApprovalByTransactionHash(ctx context.Co
 		return InfoGetTransactionResult{}, err
 	}
 
+	result.rawJSON = resp.Result
 	return result, nil
 }
 
@@ -120,8 +148,25 @@ func (c *client) GetTransactionFinalizedApprovalByDeployHash(ctx context.Context
 		return InfoGetTransactionResult{}, err
 	}
 
+	api, err := c.resolveNodeAPI(ctx)
+	if err != nil {
+		return InfoGetTransactionResult{}, err
+	}
+
 	var result InfoGetTransactionResult
-	_, err = c.processRequest(ctx, MethodGetTransaction, ParamTransactionHash{
+	if api == nodeAPIV1 {
+		resp, err := c.processRequest(ctx, MethodGetDeploy, map[string]interface{}{
+			"deploy_hash":         hash.ToHex(),
+			"finalized_approvals": true,
+		}, &result)
+		if err != nil {
+			return InfoGetTransactionResult{}, err
+		}
+		result.rawJSON = resp.Result
+		return result, nil
+	}
+
+	resp, err := c.processRequest(ctx, MethodGetTransaction, ParamTransactionHash{
 		TransactionHash: types.TransactionHash{
 			Deploy: &hash,
 		},
@@ -131,6 +176,7 @@ func (c *client) GetTransactionFinalizedApprovalByDeployHash(ctx context.Context
 		return InfoGetTransactionResult{}, err
 	}
 
+	result.rawJSON = resp.Result
 	return result, nil
 }
 
@@ -442,33 +488,36 @@ func (c *client) GetEraInfoByBlockHash(ctx context.Context, hash string) (ChainG
 func (c *client) GetLatestBlock(ctx context.Context) (ChainGetBlockResult, error) {
 	var result ChainGetBlockResult
 
-	_, err := c.processRequest(ctx, MethodGetBlock, nil, &result)
+	resp, err := c.processRequest(ctx, MethodGetBlock, nil, &result)
 	if err != nil {
 		return ChainGetBlockResult{}, err
 	}
 
+	result.rawJSON = resp.Result
 	return result, nil
 }
 
 func (c *client) GetBlockByHash(ctx context.Context, hash string) (ChainGetBlockResult, error) {
 	var result ChainGetBlockResult
 
-	_, err := c.processRequest(ctx, MethodGetBlock, NewParamBlockByHash(hash), &result)
+	resp, err := c.processRequest(ctx, MethodGetBlock, NewParamBlockByHash(hash), &result)
 	if err != nil {
 		return ChainGetBlockResult{}, err
 	}
 
+	result.rawJSON = resp.Result
 	return result, nil
 }
 
 func (c *client) GetBlockByHeight(ctx context.Context, height uint64) (ChainGetBlockResult, error) {
 	var result ChainGetBlockResult
 
-	_, err := c.processRequest(ctx, MethodGetBlock, NewParamBlockByHeight(height), &result)
+	resp, err := c.processRequest(ctx, MethodGetBlock, NewParamBlockByHeight(height), &result)
 	if err != nil {
 		return ChainGetBlockResult{}, err
 	}
 
+	result.rawJSON = resp.Result
 	return result, nil
 }
 
@@ -545,78 +594,72 @@ func (c *client) GetEraSummaryByHeight(ctx context.Context, height uint64) (Chai
 }
 
 func (c *client) GetLatestAuctionInfo(ctx context.Context) (StateGetAuctionInfoResult, error) {
-	resV2, err := c.getLatestAuctionInfoV2(ctx)
+	api, err := c.resolveNodeAPI(ctx)
 	if err != nil {
-		if !strings.Contains(err.Error(), "Method not found") {
-			return StateGetAuctionInfoResult{}, err
-		}
+		return StateGetAuctionInfoResult{}, err
+	}
 
-		resV1, err := c.GetLatestAuctionInfoV1(ctx)
-		if err != nil {
+	if api != nodeAPIV1 {
+		resV2, err := c.getLatestAuctionInfoV2(ctx)
+		if err == nil {
+			return newStateGetAuctionInfoResultFromV2(resV2), nil
+		}
+		if api == nodeAPIV2 || !isMethodNotFound(err) {
 			return StateGetAuctionInfoResult{}, err
 		}
-		return StateGetAuctionInfoResult{
-			Version:      resV1.Version,
-			AuctionState: types.NewAuctionStateFromV1(resV1.AuctionState),
-			rawJSON:      resV1.GetRawJSON(),
-		}, nil
 	}
 
-	return StateGetAuctionInfoResult{
-		Version:      resV2.Version,
-		AuctionState: types.NewAuctionStateFromV2(resV2.AuctionState),
-		rawJSON:      resV2.GetRawJSON(),
-	}, nil
+	resV1, err := c.GetLatestAuctionInfoV1(ctx)
+	if err != nil {
+		return StateGetAuctionInfoResult{}, err
+	}
+	return newStateGetAuctionInfoResultFromV1(resV1), nil
 }
 
 func (c *client) GetAuctionInfoByHash(ctx context.Context, blockHash string) (StateGetAuctionInfoResult, error) {
-	resV2, err := c.getAuctionInfoV2ByHash(ctx, blockHash)
+	api, err := c.resolveNodeAPI(ctx)
 	if err != nil {
-		if !strings.Contains(err.Error(), "Method not found") {
-			return StateGetAuctionInfoResult{}, err
-		}
+		return StateGetAuctionInfoResult{}, err
+	}
 
-		resV1, err := c.GetAuctionInfoV1ByHash(ctx, blockHash)
-		if err != nil {
+	if api != nodeAPIV1 {
+		resV2, err := c.getAuctionInfoV2ByHash(ctx, blockHash)
+		if err == nil {
+			return newStateGetAuctionInfoResultFromV2(resV2), nil
+		}
+		if api == nodeAPIV2 || !isMethodNotFound(err) {
 			return StateGetAuctionInfoResult{}, err
 		}
-		return StateGetAuctionInfoResult{
-			Version:      resV1.Version,
-			AuctionState: types.NewAuctionStateFromV1(resV1.AuctionState),
-			rawJSON:      resV1.GetRawJSON(),
-		}, nil
 	}
 
-	return StateGetAuctionInfoResult{
-		Version:      resV2.Version,
-		AuctionState: types.NewAuctionStateFromV2(resV2.AuctionState),
-		rawJSON:      resV2.GetRawJSON(),
-	}, nil
+	resV1, err := c.GetAuctionInfoV1ByHash(ctx, blockHash)
+	if err != nil {
+		return StateGetAuctionInfoResult{}, err
+	}
+	return newStateGetAuctionInfoResultFromV1(resV1), nil
 }
 
 func (c *client) GetAuctionInfoByHeight(ctx context.Context, height uint64) (StateGetAuctionInfoResult, error) {
-	resV2, err := c.getAuctionInfoV2ByHeight(ctx, height)
+	api, err := c.resolveNodeAPI(ctx)
 	if err != nil {
-		if !strings.Contains(err.Error(), "Method not found") {
-			return StateGetAuctionInfoResult{}, err
-		}
+		return StateGetAuctionInfoResult{}, err
+	}
 
-		resV1, err := c.GetAuctionInfoV1ByHeight(ctx, height)
-		if err != nil {
+	if api != nodeAPIV1 {
+		resV2, err := c.getAuctionInfoV2ByHeight(ctx, height)
+		if err == nil {
+			return newStateGetAuctionInfoResultFromV2(resV2), nil
+		}
+		if api == nodeAPIV2 || !isMethodNotFound(err) {
 			return StateGetAuctionInfoResult{}, err
 		}
-		return StateGetAuctionInfoResult{
-			Version:      resV1.Version,
-			AuctionState: types.NewAuctionStateFromV1(resV1.AuctionState),
-			rawJSON:      resV1.GetRawJSON(),
-		}, nil
 	}
 
-	return StateGetAuctionInfoResult{
-		Version:      resV2.Version,
-		AuctionState: types.NewAuctionStateFromV2(resV2.AuctionState),
-		rawJSON:      resV2.GetRawJSON(),
-	}, nil
+	resV1, err := c.GetAuctionInfoV1ByHeight(ctx, height)
+	if err != nil {
+		return StateGetAuctionInfoResult{}, err
+	}
+	return newStateGetAuctionInfoResultFromV1(resV1), nil
 }
 
 func (c *client) GetLatestAuctionInfoV1(ctx context.Context) (StateGetAuctionInfoV1Result, error) {
@@ -701,17 +744,57 @@ func (c *client) GetValidatorChangesInfo(ctx context.Context) (InfoGetValidatorC
 }
 
 func (c *client) GetStatus(ctx context.Context) (InfoGetStatusResult, error) {
-	var result InfoGetStatusResult
-
-	resp, err := c.processRequest(ctx, MethodGetStatus, nil, &result)
+	result, err := c.getStatus(ctx)
 	if err != nil {
 		return InfoGetStatusResult{}, err
 	}
 
-	result.rawJSON = resp.Result
+	c.versions.store(NodeVersion{ApiVersion: result.APIVersion, BuildVersion: result.BuildVersion})
 	return result, nil
 }
 
+func (c *client) GetNodeVersion(ctx context.Context) (NodeVersion, error) {
+	return c.versions.get(ctx, func(ctx context.Context) (NodeVersion, error) {
+		status, err := c.getStatus(ctx)
+		if err != nil {
+			return NodeVersion{}, fmt.Errorf("%w, %w", ErrNodeVersionUnavailable, err)
+		}
+		return NodeVersion{ApiVersion: status.APIVersion, BuildVersion: status.BuildVersion}, nil
+	})
+}
+
+// nodeAPI is the RPC API of the node chosen by its NodeVersion.
+type nodeAPI int
+
+const (
+	// nodeAPIUnknown is used if info_get_status is unavailable, e.g. behind a proxy allowing only some methods,
+	// the 2.x methods are requested with the fallback on the 1.x ones in this case.
+	nodeAPIUnknown nodeAPI = iota
+	nodeAPIV1
+	nodeAPIV2
+)
+
+// resolveNodeAPI returns the RPC API of the node, the malformed version of the node is an error.
+func (c *client) resolveNodeAPI(ctx context.Context) (nodeAPI, error) {
+	version, err := c.GetNodeVersion(ctx)
+	if errors.Is(err, ErrNodeVersionUnavailable) {
+		return nodeAPIUnknown, nil
+	}
+	if err != nil {
+		return nodeAPIUnknown, err
+	}
+	if version.IsV2() {
+		return nodeAPIV2, nil
+	}
+	return nodeAPIV1, nil
+}
+
+// isMethodNotFound reports whether the node doesn't implement the requested method.
+func isMethodNotFound(err error) bool {
+	var rpcErr *RpcError
+	return errors.As(err, &rpcErr) && rpcErr.Code == rpcMethodNotFoundCode
+}
+
 func (c *client) GetPeers(ctx context.Context) (InfoGetPeerResult, error) {
 	var result InfoGetPeerResult
 
@@ -872,6 +955,18 @@ func (c *client) GetChainspec(ctx context.Context) (InfoGetChainspecResult, erro
 	return result, nil
 }
 
+func (c *client) Discover(ctx context.Context) (RpcDiscoverResult, error) {
+	var result RpcDiscoverResult
+
+	resp, err := c.processRequest(ctx, MethodDiscover, nil, &result)
+	if err != nil {
+		return RpcDiscoverResult{}, err
+	}
+
+	result.rawJSON = resp.Result
+	return result, nil
+}
+
 func (c *client) GetValidatorRewardByEraID(ctx context.Context, validator keypair.PublicKey, eraID uint64) (InfoGetRewardResult, error) {
 	var result InfoGetRewardResult
 
@@ -1014,6 +1109,18 @@ func (c *client) GetLatestDelegatorReward(ctx context.Context, validator, delega
 	return result, nil
 }
 
+func (c *client) getStatus(ctx context.Context) (InfoGetStatusResult, error) {
+	var result InfoGetStatusResult
+
+	resp, err := c.processRequest(ctx, MethodGetStatus, nil, &result)
+	if err != nil {
+		return InfoGetStatusResult{}, err
+	}
+
+	result.rawJSON = resp.Result
+	return result, nil
+}
+
 func (c *client) getLatestAuctionInfoV2(ctx context.Context) (StateGetAuctionInfoV2Result, error) {
 	var result StateGetAuctionInfoV2Result
 
@@ -1050,11 +1157,28 @@ func (c *client) getAuctionInfoV2ByHeight(ctx context.Context, height uint64) (S
 }
 
 func (c *client) processRequest(ctx context.Context, method Method, params interface{}, result any) (RpcResponse, error) {
+	return processRequest(ctx, c.handler, method, params, result)
+}
+
+// processRequest builds the RpcRequest with the request ID propagated from the context, delegates the call to the
+// Handler and unmarshals RpcResponse.Result to the result. The StreamHandler decodes the result by itself.
+func processRequest(ctx context.Context, handler Handler, method Method, params interface{}, result any) (RpcResponse, error) {
 	request := DefaultRpcRequest(method, params)
 	if reqID := GetReqIdCtx(ctx); reqID != "0" {
 		request.ID = NewIDFromString(reqID)
 	}
-	resp, err := c.handler.ProcessCall(ctx, request)
+	if streamHandler, ok := handler.(StreamHandler); ok {
+		resp, err := streamHandler.ProcessCallStream(ctx, request, result)
+		if err != nil {
+			return resp, err
+		}
+		if resp.Error != nil {
+			return resp, fmt.Errorf("rpc call error ( method: %s), details: %w", method, resp.Error)
+		}
+		return resp, nil
+	}
+
+	resp, err := handler.ProcessCall(ctx, request)
 	if err != nil {
 		return resp, err
 	}
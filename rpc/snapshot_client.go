@@ -0,0 +1,168 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+// SnapshotClient is a read-only view over the Client pinned to a single Block.
+// The Block is resolved once on construction, every query made through the view uses
+// the state root hash or the hash of that Block, so several reads observe the same global state.
+type SnapshotClient struct {
+	client Client
+	block  types.Block
+}
+
+// NewLatestSnapshot resolves the latest Block and returns the SnapshotClient pinned to it.
+func NewLatestSnapshot(ctx context.Context, client Client) (*SnapshotClient, error) {
+	result, err := client.GetLatestBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewSnapshotFromBlock(client, result.Block), nil
+}
+
+// NewSnapshotByHash resolves the Block by hash and returns the SnapshotClient pinned to it.
+func NewSnapshotByHash(ctx context.Context, client Client, blockHash string) (*SnapshotClient, error) {
+	result, err := client.GetBlockByHash(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	return NewSnapshotFromBlock(client, result.Block), nil
+}
+
+// NewSnapshotByHeight resolves the Block by height and returns the SnapshotClient pinned to it.
+func NewSnapshotByHeight(ctx context.Context, client Client, height uint64) (*SnapshotClient, error) {
+	result, err := client.GetBlockByHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return NewSnapshotFromBlock(client, result.Block), nil
+}
+
+// NewSnapshotFromBlock returns the SnapshotClient pinned to an already known Block without additional RPC calls.
+func NewSnapshotFromBlock(client Client, block types.Block) *SnapshotClient {
+	return &SnapshotClient{client: client, block: block}
+}
+
+// Block returns the Block the snapshot is pinned to.
+func (s *SnapshotClient) Block() types.Block {
+	return s.block
+}
+
+// BlockHeader returns the header of the Block the snapshot is pinned to.
+func (s *SnapshotClient) BlockHeader() types.BlockHeader {
+	if blockV1 := s.block.GetBlockV1(); blockV1 != nil {
+		return types.NewBlockHeaderFromV1(blockV1.Header)
+	}
+	if blockV2 := s.block.GetBlockV2(); blockV2 != nil {
+		return types.NewBlockHeaderFromV2(blockV2.Header)
+	}
+	return types.BlockHeader{
+		AccumulatedSeed: s.block.AccumulatedSeed,
+		EraID:           s.block.EraID,
+		CurrentGasPrice: s.block.CurrentGasPrice,
+		Height:          s.block.Height,
+		ParentHash:      s.block.ParentHash,
+		Proposer:        s.block.Proposer,
+		ProtocolVersion: s.block.ProtocolVersion,
+		RandomBit:       s.block.RandomBit,
+		StateRootHash:   s.block.StateRootHash,
+		Timestamp:       s.block.Timestamp,
+		EraEnd:          s.block.EraEnd,
+	}
+}
+
+// BlockHash returns the hash of the pinned Block.
+func (s *SnapshotClient) BlockHash() string {
+	return s.block.Hash.ToHex()
+}
+
+// BlockHeight returns the height of the pinned Block.
+func (s *SnapshotClient) BlockHeight() uint64 {
+	return s.block.Height
+}
+
+// StateRootHash returns the state root hash of the pinned Block.
+func (s *SnapshotClient) StateRootHash() string {
+	return s.block.StateRootHash.ToHex()
+}
+
+func (s *SnapshotClient) blockIdentifier() *ParamBlockIdentifier {
+	param := NewParamBlockByHash(s.BlockHash())
+	return &param
+}
+
+// GetBalance returns a purse's balance at the pinned Block.
+func (s *SnapshotClient) GetBalance(ctx context.Context, purseURef string) (StateGetBalanceResult, error) {
+	return s.client.GetBalanceByStateRootHash(ctx, purseURef, s.StateRootHash())
+}
+
+// QueryBalance queries for balances under a given PurseIdentifier at the pinned Block.
+func (s *SnapshotClient) QueryBalance(ctx context.Context, identifier PurseIdentifier) (QueryBalanceResult, error) {
+	return s.client.QueryBalanceByStateRootHash(ctx, identifier, s.StateRootHash())
+}
+
+// QueryBalanceDetails queries for full balance information under a given PurseIdentifier at the pinned Block.
+func (s *SnapshotClient) QueryBalanceDetails(ctx context.Context, identifier PurseIdentifier) (QueryBalanceDetailsResult, error) {
+	return s.client.QueryBalanceDetailsByStateRootHash(ctx, identifier, s.StateRootHash())
+}
+
+// GetDictionaryItem returns an item from a Dictionary at the pinned Block.
+func (s *SnapshotClient) GetDictionaryItem(ctx context.Context, uref, key string) (StateGetDictionaryResult, error) {
+	stateRootHash := s.StateRootHash()
+	return s.client.GetDictionaryItem(ctx, &stateRootHash, uref, key)
+}
+
+// GetDictionaryItemByIdentifier returns an item from a Dictionary by the identifier at the pinned Block.
+func (s *SnapshotClient) GetDictionaryItemByIdentifier(ctx context.Context, identifier ParamDictionaryIdentifier) (StateGetDictionaryResult, error) {
+	stateRootHash := s.StateRootHash()
+	return s.client.GetDictionaryItemByIdentifier(ctx, &stateRootHash, identifier)
+}
+
+// QueryGlobalState queries for a value stored under certain keys in global state at the pinned Block.
+func (s *SnapshotClient) QueryGlobalState(ctx context.Context, key string, path []string) (QueryGlobalStateResult, error) {
+	return s.client.QueryGlobalStateByBlockHash(ctx, s.BlockHash(), key, path)
+}
+
+// GetAccountInfo returns an Account at the pinned Block.
+func (s *SnapshotClient) GetAccountInfo(ctx context.Context, accountIdentifier AccountIdentifier) (StateGetAccountInfo, error) {
+	return s.client.GetAccountInfo(ctx, s.blockIdentifier(), accountIdentifier)
+}
+
+// GetEntity returns an AddressableEntity at the pinned Block.
+func (s *SnapshotClient) GetEntity(ctx context.Context, entityIdentifier EntityIdentifier) (StateGetEntityResult, error) {
+	return s.client.GetEntityByBlockHash(ctx, entityIdentifier, s.BlockHash())
+}
+
+// GetPackage returns a Package at the pinned Block.
+func (s *SnapshotClient) GetPackage(ctx context.Context, packageIdentifier PackageIdentifier) (StateGetPackage, error) {
+	return s.client.GetPackage(ctx, packageIdentifier, s.blockIdentifier())
+}
+
+// GetAuctionInfo returns the types.AuctionState at the pinned Block.
+func (s *SnapshotClient) GetAuctionInfo(ctx context.Context) (StateGetAuctionInfoResult, error) {
+	return s.client.GetAuctionInfoByHash(ctx, s.BlockHash())
+}
+
+// GetEraSummary returns the era summary at the pinned Block.
+func (s *SnapshotClient) GetEraSummary(ctx context.Context) (ChainGetEraSummaryResult, error) {
+	return s.client.GetEraSummaryByHash(ctx, s.BlockHash())
+}
+
+// GetBlockTransfers returns all native transfers within the pinned Block.
+func (s *SnapshotClient) GetBlockTransfers(ctx context.Context) (ChainGetBlockTransfersResult, error) {
+	return s.client.GetBlockTransfersByHash(ctx, s.BlockHash())
+}
+
+// GetValidatorReward returns the reward for a given validator at the pinned Block.
+func (s *SnapshotClient) GetValidatorReward(ctx context.Context, validator keypair.PublicKey) (InfoGetRewardResult, error) {
+	return s.client.GetValidatorRewardByBlockHash(ctx, validator, s.BlockHash())
+}
+
+// GetDelegatorReward returns the delegator reward for a given validator at the pinned Block.
+func (s *SnapshotClient) GetDelegatorReward(ctx context.Context, validator, delegator keypair.PublicKey) (InfoGetRewardResult, error) {
+	return s.client.GetDelegatorRewardByBlockHash(ctx, validator, delegator, s.BlockHash())
+}
@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// StreamHandler is implemented by the handlers that decode the result directly from the underlying stream.
+// The client uses it instead of Handler.ProcessCall when available, so the handler decorators that implement
+// only the Handler interface switch the client to the buffered decoding.
+type StreamHandler interface {
+	Handler
+	// ProcessCallStream processes the call and decodes RpcResponse.Result into the result.
+	// RpcResponse.Result contains the raw JSON only if the handler keeps it.
+	ProcessCallStream(ctx context.Context, params RpcRequest, result any) (RpcResponse, error)
+}
+
+// decodeRpcResponseStream reads the RpcResponse object token by token and decodes the result value directly into the result.
+// The decoder buffers only the value being decoded, the result value is decoded once.
+// If keepRawJSON is set, the bytes read from the reader are copied aside while the result is decoded,
+// so RpcResponse.Result is cut from this copy instead of decoding the result twice.
+func decodeRpcResponseStream(reader io.Reader, result any, keepRawJSON bool) (RpcResponse, error) {
+	var rpcResponse RpcResponse
+	var captured *rawCapture
+	if keepRawJSON {
+		captured = &rawCapture{}
+		reader = io.TeeReader(reader, captured)
+	}
+	decoder := json.NewDecoder(reader)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return RpcResponse{}, streamDecodeError(ErrRpcResponseUnmarshal, err)
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return RpcResponse{}, streamDecodeError(ErrRpcResponseUnmarshal, err)
+		}
+		name, ok := token.(string)
+		if !ok {
+			return RpcResponse{}, fmt.Errorf("%w, details: unexpected token %v", ErrRpcResponseUnmarshal, token)
+		}
+
+		switch name {
+		case "jsonrpc":
+			err = decoder.Decode(&rpcResponse.Version)
+		case "id":
+			err = decoder.Decode(&rpcResponse.Id)
+		case "error":
+			err = decoder.Decode(&rpcResponse.Error)
+		case "result":
+			start := decoder.InputOffset()
+			if err = decoder.Decode(result); err != nil {
+				return rpcResponse, streamDecodeError(ErrResultUnmarshal, err)
+			}
+			if captured != nil {
+				rpcResponse.Result = captured.value(start, decoder.InputOffset())
+			}
+			continue
+		default:
+			var skipped json.RawMessage
+			err = decoder.Decode(&skipped)
+		}
+		if err != nil {
+			return RpcResponse{}, streamDecodeError(ErrRpcResponseUnmarshal, err)
+		}
+		if captured != nil && !captured.stopped {
+			// the bytes before the result are not needed anymore
+			captured.discardBefore(decoder.InputOffset())
+		}
+	}
+
+	if err := expectDelim(decoder, '}'); err != nil {
+		return RpcResponse{}, streamDecodeError(ErrRpcResponseUnmarshal, err)
+	}
+	return rpcResponse, nil
+}
+
+// rawCapture keeps the bytes read from the response body starting from the offset, which is moved forward by discardBefore.
+// The capturing stops once the result value is taken.
+type rawCapture struct {
+	buffer  bytes.Buffer
+	offset  int64
+	stopped bool
+}
+
+func (c *rawCapture) Write(p []byte) (int, error) {
+	if c.stopped {
+		return len(p), nil
+	}
+	return c.buffer.Write(p)
+}
+
+func (c *rawCapture) discardBefore(offset int64) {
+	c.buffer.Next(int(offset - c.offset))
+	c.offset = offset
+}
+
+// value returns the JSON value read between the offsets, the separating colon and the whitespaces are trimmed.
+// The value shares the memory with the buffer, so the capturing is stopped.
+func (c *rawCapture) value(start, end int64) json.RawMessage {
+	c.stopped = true
+	data := c.buffer.Bytes()[start-c.offset : end-c.offset]
+	return bytes.TrimLeft(data, " \t\r\n:")
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %s, got %v", delim, token)
+	}
+	return nil
+}
+
+func streamDecodeError(target, err error) error {
+	if errors.Is(err, ErrResponseTooLarge) {
+		return err
+	}
+	return fmt.Errorf("%w, details: %s", target, err.Error())
+}
@@ -0,0 +1,183 @@
+package casper
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+)
+
+func Test_ParseAmount(t *testing.T) {
+	tests := []struct {
+		source string
+		motes  string
+	}{
+		{source: "1.5 CSPR", motes: "1500000000"},
+		{source: "1500000000 motes", motes: "1500000000"},
+		{source: "1_000 CSPR", motes: "1000000000000"},
+		{source: "0.000000001 cspr", motes: "1"},
+		{source: "2.5CSPR", motes: "2500000000"},
+		{source: "1 mote", motes: "1"},
+		{source: "42", motes: "42"},
+		{source: "100000000000000000000000000000 CSPR", motes: "100000000000000000000000000000000000000"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			amount, err := casper.ParseAmount(test.source)
+			require.NoError(t, err)
+			assert.Equal(t, test.motes, amount.Motes().String())
+		})
+	}
+}
+
+func Test_ParseAmount_Errors(t *testing.T) {
+	tests := []struct {
+		source string
+		target error
+	}{
+		{source: "0.0000000001 CSPR", target: casper.ErrInvalidAmount},
+		{source: "1.5 motes", target: casper.ErrInvalidAmount},
+		{source: "1_ CSPR", target: casper.ErrInvalidAmount},
+		{source: "_1 CSPR", target: casper.ErrInvalidAmount},
+		{source: "1e3 motes", target: casper.ErrInvalidAmount},
+		{source: "1 ETH", target: casper.ErrInvalidAmount},
+		{source: "", target: casper.ErrInvalidAmount},
+		{source: "-1 CSPR", target: casper.ErrAmountOverflow},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			_, err := casper.ParseAmount(test.source)
+			assert.True(t, errors.Is(err, test.target), err)
+		})
+	}
+}
+
+func Test_Amount_Format(t *testing.T) {
+	amount, err := casper.ParseAmount("1.56789 CSPR")
+	require.NoError(t, err)
+	assert.Equal(t, "1.56789 CSPR", amount.String())
+	assert.Equal(t, "1.56", amount.FormatCSPR(2))
+	assert.Equal(t, "1.567890000", amount.FormatCSPR(casper.CSPRDecimals))
+	assert.Equal(t, "2", casper.NewAmountFromCSPR(2).FormatCSPR(0))
+	assert.Equal(t, "0 CSPR", casper.Amount{}.String())
+
+	parsed, err := casper.ParseAmount(amount.String())
+	require.NoError(t, err)
+	assert.Equal(t, 0, amount.Cmp(parsed))
+}
+
+func Test_Amount_Arithmetic(t *testing.T) {
+	one := casper.NewAmountFromCSPR(1)
+	half, err := casper.ParseAmount("0.5 CSPR")
+	require.NoError(t, err)
+
+	sum, err := one.Add(half)
+	require.NoError(t, err)
+	assert.Equal(t, "1500000000", sum.Motes().String())
+
+	difference, err := sum.Sub(one)
+	require.NoError(t, err)
+	assert.Equal(t, 0, difference.Cmp(half))
+	_, err = half.Sub(one)
+	assert.True(t, errors.Is(err, casper.ErrAmountOverflow))
+
+	product, err := half.Mul(3)
+	require.NoError(t, err)
+	assert.Equal(t, "1.5 CSPR", product.String())
+
+	maxAmount, err := casper.NewAmount(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 512), big.NewInt(1)))
+	require.NoError(t, err)
+	_, err = maxAmount.Add(casper.NewAmountFromMotes(1))
+	assert.True(t, errors.Is(err, casper.ErrAmountOverflow))
+	_, err = maxAmount.Uint64()
+	assert.True(t, errors.Is(err, casper.ErrAmountOverflow))
+
+	// the operands are not modified
+	assert.Equal(t, "1000000000", one.Motes().String())
+	assert.True(t, casper.Amount{}.IsZero())
+}
+
+func Test_Amount_JSON(t *testing.T) {
+	var data struct {
+		Amount casper.Amount `json:"amount"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"2500000000"}`), &data))
+	assert.Equal(t, "2.5 CSPR", data.Amount.String())
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":2500}`), &data))
+	assert.Equal(t, "2500", data.Amount.Motes().String())
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"1.5 CSPR"}`), &data))
+
+	result, err := json.Marshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"1500000000"}`, string(result))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":true}`), &data))
+}
+
+func Test_Amount_SQL(t *testing.T) {
+	amount := casper.NewAmountFromCSPR(3)
+	value, err := amount.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "3000000000", value)
+
+	var scanned casper.Amount
+	require.NoError(t, scanned.Scan([]byte("3000000000")))
+	assert.Equal(t, 0, amount.Cmp(scanned))
+	require.NoError(t, scanned.Scan("25.000"))
+	assert.Equal(t, "25", scanned.Motes().String())
+	require.NoError(t, scanned.Scan(int64(7)))
+	assert.Equal(t, "7", scanned.Motes().String())
+	assert.ErrorIs(t, scanned.Scan(1.5), casper.ErrInvalidAmount)
+	assert.ErrorIs(t, scanned.Scan(float64(7)), casper.ErrInvalidAmount)
+	assert.ErrorIs(t, scanned.Scan(true), casper.ErrInvalidAmount)
+	assert.Equal(t, "7", scanned.Motes().String())
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsZero())
+
+	var nullable sql.Null[casper.Amount]
+	require.NoError(t, nullable.Scan(nil))
+	assert.False(t, nullable.Valid)
+	require.NoError(t, nullable.Scan("1500000000"))
+	assert.True(t, nullable.Valid)
+	assert.Equal(t, "1500000000", nullable.V.Motes().String())
+}
+
+func Test_Amount_Conversions(t *testing.T) {
+	amount, err := casper.ParseAmount("2.5 CSPR")
+	require.NoError(t, err)
+
+	value := amount.CLValue()
+	assert.Equal(t, "2500000000", value.UI512.String())
+	fromValue, err := casper.NewAmountFromCLValue(value)
+	require.NoError(t, err)
+	assert.Equal(t, 0, amount.Cmp(fromValue))
+
+	fromUInt512, err := casper.NewAmountFromUInt512(*clvalue.NewCLUInt512(big.NewInt(2500000000)).UI512)
+	require.NoError(t, err)
+	assert.Equal(t, 0, amount.Cmp(fromUInt512))
+	uint512 := amount.UInt512()
+	assert.Equal(t, "2500000000", uint512.String())
+
+	_, err = casper.NewAmountFromCLValue(*clvalue.NewCLString("1"))
+	assert.Error(t, err)
+
+	payment := amount.StandardPayment()
+	fromPayment, err := casper.NewAmountFromPayment(payment)
+	require.NoError(t, err)
+	assert.Equal(t, 0, amount.Cmp(fromPayment))
+	_, err = casper.NewAmountFromPayment(casper.ExecutableDeployItem{})
+	assert.True(t, errors.Is(err, casper.ErrInvalidAmount))
+
+	assert.Equal(t, "1.5 CSPR", casper.Motes(1500000000).Amount().String())
+	motes, err := amount.Uint64()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2500000000), motes)
+}
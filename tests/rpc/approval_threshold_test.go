@@ -0,0 +1,130 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/rpc"
+	"github.com/make-software/casper-go-sdk/v2/types"
+)
+
+func Test_CheckApprovalThresholds(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	associatedKeys := []types.AssociatedKey{{AccountHash: keys.PublicKey().AccountHash(), Weight: 1}}
+
+	transactionV1, err := types.NewTransferTransactionBuilder().
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(keys.PublicKey()).
+		WithTargetPublicKey(keys.PublicKey()).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+	require.NoError(t, transactionV1.Sign(keys))
+	transaction := types.NewTransactionFromTransactionV1(*transactionV1)
+
+	tests := map[string]map[rpc.Method]any{
+		"addressable entity": {
+			rpc.MethodGetStatus: map[string]any{"api_version": "2.0.0"},
+			rpc.MethodGetStateEntity: map[string]any{
+				"api_version": "2.0.0",
+				"entity": rpc.EntityOrAccount{AddressableEntity: &rpc.AddressableEntity{
+					Entity: types.AddressableEntity{
+						AssociatedKeys:   associatedKeys,
+						ActionThresholds: types.EntityActionThresholds{Deployment: 1, UpgradeManagement: 1, KeyManagement: 2},
+					},
+				}},
+			},
+		},
+		"legacy account": {
+			rpc.MethodGetStatus: map[string]any{"api_version": "2.0.0"},
+			rpc.MethodGetStateEntity: map[string]any{
+				"api_version": "2.0.0",
+				"entity": rpc.EntityOrAccount{LegacyAccount: &types.Account{
+					AssociatedKeys:   associatedKeys,
+					ActionThresholds: types.ActionThresholds{Deployment: 1, KeyManagement: 2},
+				}},
+			},
+		},
+		"node without entities": {
+			rpc.MethodGetStatus: map[string]any{"api_version": "1.5.0"},
+			rpc.MethodGetStateAccount: map[string]any{
+				"api_version": "1.5.0",
+				"account": types.Account{
+					AssociatedKeys:   associatedKeys,
+					ActionThresholds: types.ActionThresholds{Deployment: 1, KeyManagement: 2},
+				},
+			},
+		},
+	}
+	for name, results := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := setupResultsServer(t, results)
+			defer server.Close()
+
+			client := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient))
+			thresholds, err := rpc.CheckApprovalThresholds(context.Background(), client, transaction)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(1), thresholds.Weight)
+			assert.True(t, thresholds.DeploymentMet)
+			assert.False(t, thresholds.KeyManagementMet)
+			assert.Empty(t, thresholds.MissingKeys)
+		})
+	}
+}
+
+func Test_CheckApprovalThresholds_ChoosesMethodByNodeVersion(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	transactionV1, err := types.NewTransferTransactionBuilder().
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(keys.PublicKey()).
+		WithTargetPublicKey(keys.PublicKey()).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+	transaction := types.NewTransactionFromTransactionV1(*transactionV1)
+
+	// the 2.x node doesn't fall back on the account method if the entity method fails
+	server := setupResultsServer(t, map[rpc.Method]any{
+		rpc.MethodGetStatus:       map[string]any{"api_version": "2.0.0"},
+		rpc.MethodGetStateAccount: map[string]any{"api_version": "2.0.0", "account": types.Account{}},
+	})
+	defer server.Close()
+	_, err = rpc.CheckApprovalThresholds(context.Background(), rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient)), transaction)
+	var rpcErr *rpc.RpcError
+	require.True(t, errors.As(err, &rpcErr))
+	assert.Equal(t, -32601, rpcErr.Code)
+
+	// the version has to be known
+	server = setupResultsServer(t, map[rpc.Method]any{
+		rpc.MethodGetStateAccount: map[string]any{"api_version": "1.5.0", "account": types.Account{}},
+	})
+	defer server.Close()
+	_, err = rpc.CheckApprovalThresholds(context.Background(), rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient)), transaction)
+	assert.ErrorIs(t, err, rpc.ErrNodeVersionUnavailable)
+}
+
+// setupResultsServer responds with the result of the requested method, the other methods are not found.
+func setupResultsServer(t *testing.T, results map[rpc.Method]any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var request rpc.RpcRequest
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&request))
+		result, ok := results[request.Method]
+		if !ok {
+			_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":"1","error":{"code":-32601,"message":"Method not found"}}`))
+			require.NoError(t, err)
+			return
+		}
+		require.NoError(t, json.NewEncoder(rw).Encode(map[string]any{"jsonrpc": "2.0", "id": "1", "result": result}))
+	}))
+}
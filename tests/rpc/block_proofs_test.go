@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/types"
+)
+
+func Test_Block_VerifyProofs(t *testing.T) {
+	tests := []struct {
+		filePath  string
+		chainName string
+		proofs    int
+	}{
+		{
+			filePath: "../data/rpc_response/get_block_v1.json",
+			proofs:   100,
+		},
+		{
+			filePath:  "../data/rpc_response/get_block_v2_era_end.json",
+			chainName: "dev-net",
+			proofs:    4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filePath, func(t *testing.T) {
+			server := SetupServer(t, tt.filePath)
+			defer server.Close()
+			client := casper.NewRPCClient(casper.NewRPCHandler(server.URL, http.DefaultClient))
+			result, err := client.GetLatestBlock(context.Background())
+			require.NoError(t, err)
+			block := result.Block
+			require.Len(t, block.Proofs, tt.proofs)
+			assert.NoError(t, block.VerifyProofs(tt.chainName))
+
+			block.Proofs = append([]types.Proof{}, block.Proofs...)
+			for _, i := range []int{0, len(block.Proofs) - 1} {
+				signature := append(types.HexBytes{}, block.Proofs[i].Signature...)
+				signature[10] ^= 0xff
+				block.Proofs[i].Signature = signature
+			}
+			err = block.VerifyProofs(tt.chainName)
+			assert.True(t, errors.Is(err, types.ErrInvalidBlockProof))
+			assert.Contains(t, err.Error(), fmt.Sprintf("proofs: [0 %d]", tt.proofs-1))
+		})
+	}
+}
+
+func Test_Block_VerifyProofs_BlockV2RequiresChainName(t *testing.T) {
+	server := SetupServer(t, "../data/rpc_response/get_block_v2_era_end.json")
+	defer server.Close()
+	client := casper.NewRPCClient(casper.NewRPCHandler(server.URL, http.DefaultClient))
+	result, err := client.GetLatestBlock(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, errors.Is(result.Block.VerifyProofs(""), types.ErrEmptyChainName))
+	assert.True(t, errors.Is(result.Block.VerifyProofs("casper"), types.ErrInvalidBlockProof))
+}
@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/rpc"
+	"github.com/make-software/casper-go-sdk/v2/tests/helper"
+)
+
+func Test_Call_KnownResultType_KeepsRawJSON(t *testing.T) {
+	var receivedRequest rpc.RpcRequest
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&receivedRequest))
+		fixture, err := os.ReadFile("../data/rpc_response/get_status.json")
+		require.NoError(t, err)
+		_, err = rw.Write(fixture)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := rpc.NewClient(helper.NewTestLoggerDecorator(rpc.NewHttpHandler(server.URL, http.DefaultClient)))
+	ctx := rpc.WithRequestId(context.Background(), 42)
+	result, err := rpc.Call[rpc.InfoGetStatusResult](ctx, client, rpc.MethodGetStatus, nil)
+	require.NoError(t, err)
+	assert.Equal(t, rpc.MethodGetStatus, receivedRequest.Method)
+	assert.Equal(t, "42", receivedRequest.ID.String())
+	assert.Equal(t, "2.0.0", result.APIVersion)
+	assert.NotEmpty(t, result.GetRawJSON())
+}
+
+func Test_Call_CustomResultType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":"1","result":{"api_version":"2.0.0","value":7}}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	type customResult struct {
+		ApiVersion string `json:"api_version"`
+		Value      uint64 `json:"value"`
+	}
+	client := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient))
+	result, err := rpc.Call[rpc.RawResult[customResult]](context.Background(), client, "custom_method", map[string]string{"key": "value"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), result.Value.Value)
+	assert.JSONEq(t, `{"api_version":"2.0.0","value":7}`, string(result.GetRawJSON()))
+}
+
+func Test_Call_RpcError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":"1","error":{"code":-32601,"message":"Method not found"}}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient))
+	_, err := rpc.Call[json.RawMessage](context.Background(), client, "unknown_method", nil)
+	var rpcErr *rpc.RpcError
+	require.True(t, errors.As(err, &rpcErr))
+	assert.Equal(t, -32601, rpcErr.Code)
+}
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/rpc"
+)
+
+func Test_DefaultClient_Discover(t *testing.T) {
+	server := SetupServer(t, "../data/rpc_response/rpc_discover.json")
+	defer server.Close()
+	client := casper.NewRPCClient(casper.NewRPCHandler(server.URL, http.DefaultClient))
+
+	result, err := client.(rpc.Discoverer).Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", result.ApiVersion)
+	assert.Equal(t, "1.0.0-rc1", result.Schema.OpenRPC)
+	assert.NotEmpty(t, result.GetRawJSON())
+
+	method, ok := result.Schema.Method(rpc.MethodGetStateRootHash)
+	require.True(t, ok)
+	assert.Equal(t, "block_identifier", method.Params[0].Name)
+	assert.True(t, method.Result.Schema.Properties["api_version"].Type.Has("string"))
+
+	blockHash := result.Schema.ResolveSchema(&rpc.JSONSchema{Ref: "#/components/schemas/BlockHash"})
+	require.NotNil(t, blockHash)
+	assert.Equal(t, "Hex-encoded hash digest.", blockHash.Description)
+}
+
+func Test_VerifyCoverage_ReportsUncoveredMethodsAndDroppedFields(t *testing.T) {
+	server := SetupServer(t, "../data/rpc_response/rpc_discover.json")
+	defer server.Close()
+	client := casper.NewRPCClient(casper.NewRPCHandler(server.URL, http.DefaultClient))
+	result, err := client.(rpc.Discoverer).Discover(context.Background())
+	require.NoError(t, err)
+
+	report := rpc.VerifyCoverage(result.Schema)
+	assert.False(t, report.IsFull())
+	assert.Equal(t, []string{"info_get_network_fingerprint"}, report.UncoveredMethods)
+	assert.Contains(t, report.UnavailableMethods, rpc.MethodGetDeploy)
+	assert.NotContains(t, report.UnavailableMethods, rpc.MethodGetStatus)
+	assert.Equal(t, []rpc.DroppedField{
+		{Method: rpc.MethodGetStatus, Path: "last_added_block_info.proposer_signature"},
+		{Method: rpc.MethodGetStatus, Path: "node_uptime_ms"},
+	}, report.DroppedFields)
+}
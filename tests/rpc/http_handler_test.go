@@ -0,0 +1,219 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/rpc"
+)
+
+func setupFixtureServer(t *testing.T, filePath string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		fixture, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		_, err = rw.Write(fixture)
+		require.NoError(t, err)
+	}))
+}
+
+func Test_HttpHandler_Streaming_GetBlock(t *testing.T) {
+	server := setupFixtureServer(t, "../data/rpc_response/get_block_v2.json")
+	defer server.Close()
+
+	handler := rpc.NewHttpHandler(server.URL, http.DefaultClient)
+	handler.Streaming = true
+	handler.KeepRawJSON = true
+	client := rpc.NewClient(handler)
+	result, err := client.GetLatestBlock(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "0744fcb72af43c5cc372039bc5a8bfee48808a9ce414acc0d6338a628c20eb42", result.Block.Hash.ToHex())
+	assert.NotEmpty(t, result.GetRawJSON())
+
+	fixture, err := os.ReadFile("../data/rpc_response/get_block_v2.json")
+	require.NoError(t, err)
+	var expected struct {
+		Result json.RawMessage `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(fixture, &expected))
+	assert.JSONEq(t, string(expected.Result), string(result.GetRawJSON()))
+}
+
+func Test_HttpHandler_Streaming_WithoutRawJSON(t *testing.T) {
+	server := setupFixtureServer(t, "../data/rpc_response/get_status.json")
+	defer server.Close()
+
+	handler := rpc.NewHttpHandler(server.URL, http.DefaultClient)
+	handler.Streaming = true
+	client := rpc.NewClient(handler)
+	result, err := client.GetStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", result.APIVersion)
+	assert.NotEmpty(t, result.Peers)
+	assert.Empty(t, result.GetRawJSON())
+}
+
+func Test_HttpHandler_Streaming_ResultsWithCustomDecoding(t *testing.T) {
+	tests := map[string]struct {
+		filePath string
+		call     func(client rpc.Client) (any, json.RawMessage, error)
+	}{
+		"GetLatestBlock": {
+			filePath: "../data/rpc_response/get_block_v2.json",
+			call: func(client rpc.Client) (any, json.RawMessage, error) {
+				result, err := client.GetLatestBlock(context.Background())
+				return result.Block, result.GetRawJSON(), err
+			},
+		},
+		"GetDeploy": {
+			filePath: "../data/deploy/get_raw_rpc_deploy_v2.json",
+			call: func(client rpc.Client) (any, json.RawMessage, error) {
+				result, err := client.GetDeploy(context.Background(), "599d11970dc3123ee64c32901d544cddde777beca084a693173c6b9c40877798")
+				return result.Deploy, result.GetRawJSON(), err
+			},
+		},
+		"GetTransactionByTransactionHash": {
+			filePath: "../data/transaction/get_transaction.json",
+			call: func(client rpc.Client) (any, json.RawMessage, error) {
+				result, err := client.GetTransactionByTransactionHash(context.Background(), "7ef4be88714ed23ae4d4a3f095612d638255c780a24f1fc4c6f57f7e6251f8bf")
+				return result.Transaction, result.GetRawJSON(), err
+			},
+		},
+	}
+	for name, test := range tests {
+		fixture, err := os.ReadFile(test.filePath)
+		require.NoError(t, err)
+		var expected struct {
+			Result json.RawMessage `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(fixture, &expected))
+
+		// the long id after the result makes the decoder refill its buffer once the result is decoded
+		body := `{"jsonrpc":"2.0","result":` + string(expected.Result) + `,"id":"` + strings.Repeat("1", 1<<16) + `"}`
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			_, err := rw.Write([]byte(body))
+			require.NoError(t, err)
+		}))
+		buffered, _, err := test.call(rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient)))
+		require.NoError(t, err)
+		expectedValue, err := json.Marshal(buffered)
+		require.NoError(t, err)
+
+		for _, keepRawJSON := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/KeepRawJSON=%t", name, keepRawJSON), func(t *testing.T) {
+				handler := rpc.NewHttpHandler(server.URL, http.DefaultClient)
+				handler.Streaming = true
+				handler.KeepRawJSON = keepRawJSON
+				value, rawJSON, err := test.call(rpc.NewClient(handler))
+				require.NoError(t, err)
+
+				// the decoded value doesn't keep the slices of the decoder buffer, which is reused after the result
+				actualValue, err := json.Marshal(value)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expectedValue), string(actualValue))
+				if keepRawJSON {
+					assert.JSONEq(t, string(expected.Result), string(rawJSON))
+				} else {
+					assert.Empty(t, rawJSON)
+				}
+			})
+		}
+		server.Close()
+	}
+}
+
+func Test_HttpHandler_Streaming_RpcError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, err := rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32001,"message":"block not known"},"id":"1"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	handler := rpc.NewHttpHandler(server.URL, http.DefaultClient)
+	handler.Streaming = true
+	_, err := rpc.NewClient(handler).GetBlockByHeight(context.Background(), 1)
+	var rpcErr *rpc.RpcError
+	require.True(t, errors.As(err, &rpcErr))
+	assert.Equal(t, -32001, rpcErr.Code)
+}
+
+func Test_HttpHandler_MaxResponseSize(t *testing.T) {
+	server := setupFixtureServer(t, "../data/rpc_response/get_block_v2.json")
+	defer server.Close()
+
+	for _, streaming := range []bool{false, true} {
+		handler := rpc.NewHttpHandler(server.URL, http.DefaultClient)
+		handler.Streaming = streaming
+		handler.MaxResponseSize = 1024
+		_, err := rpc.NewClient(handler).GetLatestBlock(context.Background())
+		assert.ErrorIs(t, err, rpc.ErrResponseTooLarge)
+	}
+}
+
+func Test_HttpHandler_Streaming_MemoryIsBounded(t *testing.T) {
+	const chunkSize = 64 << 10
+	chunk := bytes.Repeat([]byte("x"), chunkSize)
+	// the server streams the padding of the result from the same chunk, so its own allocations don't depend on the size
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		chunks := 16
+		if req.URL.Path == "/huge" {
+			chunks = 16 << 10 // 1 GiB
+		}
+		_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","id":"1","result":{"api_version":"2.0.0","padding":"`))
+		for i := 0; i < chunks; i++ {
+			if _, err := rw.Write(chunk); err != nil {
+				return
+			}
+		}
+		_, _ = rw.Write([]byte(`"}}`))
+	}))
+	defer server.Close()
+
+	allocated := func(handler *rpc.HttpHandler, result any) (uint64, error) {
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		_, err := handler.ProcessCallStream(context.Background(), rpc.DefaultRpcRequest("info_get_status", nil), result)
+		runtime.ReadMemStats(&after)
+		// the total of the allocations is the upper bound of the peak memory
+		return after.TotalAlloc - before.TotalAlloc, err
+	}
+
+	const resultSize = 16 * chunkSize
+	for _, keepRawJSON := range []bool{false, true} {
+		handler := rpc.NewHttpHandler(server.URL, http.DefaultClient)
+		handler.Streaming = true
+		handler.KeepRawJSON = keepRawJSON
+		var result struct {
+			APIVersion string `json:"api_version"`
+		}
+		total, err := allocated(handler, &result)
+		require.NoError(t, err)
+		assert.Equal(t, "2.0.0", result.APIVersion)
+		// the decoder buffers the JSON of the result growing its buffer twice at a time, the raw JSON takes the same again
+		limit := uint64(5 * resultSize)
+		if keepRawJSON {
+			limit *= 2
+		}
+		assert.Less(t, total, limit, "keep raw JSON: %v", keepRawJSON)
+	}
+
+	handler := rpc.NewHttpHandler(server.URL+"/huge", http.DefaultClient)
+	handler.Streaming = true
+	handler.MaxResponseSize = resultSize
+	var result json.RawMessage
+	total, err := allocated(handler, &result)
+	assert.ErrorIs(t, err, rpc.ErrResponseTooLarge)
+	assert.Less(t, total, uint64(8*resultSize))
+}
@@ -0,0 +1,188 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/rpc"
+)
+
+func setupVersionedServer(t *testing.T, fixtures map[rpc.Method]string, calls map[rpc.Method]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var request rpc.RpcRequest
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&request))
+		calls[request.Method]++
+		filePath, ok := fixtures[request.Method]
+		if !ok {
+			_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":"1","error":{"code":-32601,"message":"Method not found"}}`))
+			require.NoError(t, err)
+			return
+		}
+		fixture, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		_, err = rw.Write(fixture)
+		require.NoError(t, err)
+	}))
+}
+
+func Test_DefaultClient_GetLatestAuctionInfo_ResolvesNodeVersionOnce(t *testing.T) {
+	calls := make(map[rpc.Method]int)
+	server := setupVersionedServer(t, map[rpc.Method]string{
+		rpc.MethodGetStatus:        "../data/rpc_response/get_status.json",
+		rpc.MethodGetAuctionInfoV2: "../data/rpc_response/state_get_auction_info_v2.json",
+	}, calls)
+	defer server.Close()
+
+	client := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient))
+	for i := 0; i < 2; i++ {
+		result, err := client.GetLatestAuctionInfo(context.Background())
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.AuctionState.Bids)
+	}
+	assert.Equal(t, 1, calls[rpc.MethodGetStatus])
+	assert.Equal(t, 2, calls[rpc.MethodGetAuctionInfoV2])
+	assert.Zero(t, calls[rpc.MethodGetAuctionInfo])
+
+	version, err := client.(rpc.NodeVersionProvider).GetNodeVersion(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", version.ApiVersion)
+	assert.True(t, version.IsV2())
+}
+
+func Test_DefaultClient_GetLatestAuctionInfo_PinnedV1Version(t *testing.T) {
+	calls := make(map[rpc.Method]int)
+	server := setupVersionedServer(t, map[rpc.Method]string{
+		rpc.MethodGetAuctionInfo: "../data/rpc_response/get_auction_info.json",
+	}, calls)
+	defer server.Close()
+
+	client := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient), rpc.WithNodeVersion(rpc.NewNodeVersion("1.5.6")))
+	result, err := client.GetLatestAuctionInfo(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AuctionState.Bids)
+	assert.Zero(t, calls[rpc.MethodGetStatus])
+	assert.Zero(t, calls[rpc.MethodGetAuctionInfoV2])
+}
+
+func Test_DefaultClient_GetTransactionByDeployHash_V1NodeUsesGetDeploy(t *testing.T) {
+	calls := make(map[rpc.Method]int)
+	server := setupVersionedServer(t, map[rpc.Method]string{
+		rpc.MethodGetDeploy: "../data/deploy/get_raw_rpc_deploy.json",
+	}, calls)
+	defer server.Close()
+
+	client := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient), rpc.WithNodeVersion(rpc.NewNodeVersion("1.5.6")))
+	result, err := client.GetTransactionByDeployHash(context.Background(), "a2c450eb80c408105dcf5a6808786a2681d4b7ef8bffd6bb59ccbbee98b908fb")
+	require.NoError(t, err)
+	assert.Equal(t, "a2c450eb80c408105dcf5a6808786a2681d4b7ef8bffd6bb59ccbbee98b908fb", result.Transaction.Hash.ToHex())
+	assert.NotNil(t, result.ExecutionInfo)
+	assert.Equal(t, 1, calls[rpc.MethodGetDeploy])
+	assert.Zero(t, calls[rpc.MethodGetTransaction])
+}
+
+func Test_DefaultClient_GetLatestAuctionInfo_StatusUnavailable(t *testing.T) {
+	calls := make(map[rpc.Method]int)
+	// info_get_status and state_get_auction_info_v2 are not allowed, as behind a proxy
+	server := setupVersionedServer(t, map[rpc.Method]string{
+		rpc.MethodGetAuctionInfo: "../data/rpc_response/get_auction_info.json",
+	}, calls)
+	defer server.Close()
+
+	client := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient))
+	for i := 0; i < 2; i++ {
+		result, err := client.GetLatestAuctionInfo(context.Background())
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.AuctionState.Bids)
+	}
+	// the failed resolution is cached, info_get_status isn't requested again
+	assert.Equal(t, 1, calls[rpc.MethodGetStatus])
+	assert.Equal(t, 2, calls[rpc.MethodGetAuctionInfoV2])
+	assert.Equal(t, 2, calls[rpc.MethodGetAuctionInfo])
+
+	_, err := client.(rpc.NodeVersionProvider).GetNodeVersion(context.Background())
+	assert.ErrorIs(t, err, rpc.ErrNodeVersionUnavailable)
+	assert.Equal(t, 1, calls[rpc.MethodGetStatus])
+}
+
+func Test_DefaultClient_GetNodeVersion_CancelledWhileResolving(t *testing.T) {
+	requested, release := make(chan struct{}), make(chan struct{})
+	var statusCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if statusCalls.Add(1) == 1 {
+			close(requested)
+		}
+		<-release
+		fixture, err := os.ReadFile("../data/rpc_response/get_status.json")
+		require.NoError(t, err)
+		_, err = rw.Write(fixture)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient)).(rpc.NodeVersionProvider)
+
+	resolved := make(chan error, 1)
+	go func() {
+		_, err := client.GetNodeVersion(context.Background())
+		resolved <- err
+	}()
+	<-requested
+
+	// the caller waiting for the resolution in progress returns once its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.GetNodeVersion(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	require.NoError(t, <-resolved)
+	version, err := client.GetNodeVersion(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", version.ApiVersion)
+	assert.Equal(t, int32(1), statusCalls.Load())
+}
+
+func Test_DefaultClient_MalformedNodeVersion(t *testing.T) {
+	calls := make(map[rpc.Method]int)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var request rpc.RpcRequest
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&request))
+		calls[request.Method]++
+		_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":"1","result":{"api_version":"","build_version":"2.0.0-abc"}}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient))
+	_, err := client.GetLatestAuctionInfo(context.Background())
+	assert.ErrorIs(t, err, rpc.ErrMalformedNodeVersion)
+	assert.Zero(t, calls[rpc.MethodGetAuctionInfo])
+	assert.Zero(t, calls[rpc.MethodGetAuctionInfoV2])
+
+	pinned := rpc.NewClient(rpc.NewHttpHandler(server.URL, http.DefaultClient), rpc.WithNodeVersion(rpc.NewNodeVersion("two")))
+	_, err = pinned.GetAuctionInfoByHeight(context.Background(), 1)
+	assert.ErrorIs(t, err, rpc.ErrMalformedNodeVersion)
+}
+
+func Test_NodeVersion_Major(t *testing.T) {
+	major, err := rpc.NewNodeVersion("1.5.6").Major()
+	require.NoError(t, err)
+	assert.Equal(t, 1, major)
+	major, err = rpc.NewNodeVersion("2.0.0").Major()
+	require.NoError(t, err)
+	assert.Equal(t, 2, major)
+	for _, malformed := range []string{"", "unknown", "-1.0.0"} {
+		_, err = rpc.NewNodeVersion(malformed).Major()
+		assert.ErrorIs(t, err, rpc.ErrMalformedNodeVersion, malformed)
+		assert.False(t, rpc.NewNodeVersion(malformed).IsV2())
+	}
+	assert.False(t, rpc.NewNodeVersion("1.5.6").IsV2())
+}
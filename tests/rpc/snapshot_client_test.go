@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/rpc"
+)
+
+func Test_SnapshotClient_PinsQueriesToResolvedBlock(t *testing.T) {
+	const (
+		blockHash     = "0744fcb72af43c5cc372039bc5a8bfee48808a9ce414acc0d6338a628c20eb42"
+		stateRootHash = "0808080808080808080808080808080808080808080808080808080808080808"
+	)
+	calls := make(map[rpc.Method]json.RawMessage)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var request struct {
+			Method rpc.Method      `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&request))
+		calls[request.Method] = request.Params
+
+		fixtures := map[rpc.Method]string{
+			rpc.MethodGetBlock:        "../data/rpc_response/get_block_v2.json",
+			rpc.MethodGetStateBalance: "../data/rpc_response/get_account_balance.json",
+			rpc.MethodGetStateAccount: "../data/rpc_response/get_account_info.json",
+		}
+		fixture, err := os.ReadFile(fixtures[request.Method])
+		require.NoError(t, err)
+		_, err = rw.Write(fixture)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := casper.NewRPCClient(casper.NewRPCHandler(server.URL, http.DefaultClient))
+	snapshot, err := rpc.NewLatestSnapshot(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, blockHash, snapshot.BlockHash())
+	assert.Equal(t, stateRootHash, snapshot.StateRootHash())
+	assert.Equal(t, snapshot.BlockHeight(), snapshot.BlockHeader().Height)
+	assert.Equal(t, stateRootHash, snapshot.BlockHeader().StateRootHash.ToHex())
+
+	balance, err := snapshot.GetBalance(context.Background(), "uref-7b12008bb757ee32caefb3f7a1f77d9f659ee7a4e21ad4950c4e0294000492eb-007")
+	require.NoError(t, err)
+	assert.Equal(t, "93000000000", balance.BalanceValue.String())
+	assert.NotContains(t, calls, rpc.MethodGetStateRootHash)
+	assert.JSONEq(t, `{"state_root_hash":"`+stateRootHash+`","purse_uref":"uref-7b12008bb757ee32caefb3f7a1f77d9f659ee7a4e21ad4950c4e0294000492eb-007"}`, string(calls[rpc.MethodGetStateBalance]))
+
+	pubKey, err := casper.NewPublicKey("01018525deae6091abccab6704a0fa44e12c495eec9e8fe6929862e1b75580e715")
+	require.NoError(t, err)
+	_, err = snapshot.GetAccountInfo(context.Background(), rpc.AccountIdentifier{PublicKey: &pubKey})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"account_identifier":"`+pubKey.String()+`","block_identifier":{"Hash":"`+blockHash+`"}}`, string(calls[rpc.MethodGetStateAccount]))
+}
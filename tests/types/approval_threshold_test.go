@@ -0,0 +1,76 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+func Test_Account_CheckApprovals(t *testing.T) {
+	firstKeys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	secondKeys, err := casper.NewSECP256k1PrivateKeyFromPEMFile("../data/keys/account_test_SECP_secret_key.pem")
+	require.NoError(t, err)
+	strangerKeys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/account_test_ED25519_secret_key.pem")
+	require.NoError(t, err)
+
+	account := types.Account{
+		AccountHash: firstKeys.PublicKey().AccountHash(),
+		AssociatedKeys: []types.AssociatedKey{
+			{AccountHash: firstKeys.PublicKey().AccountHash(), Weight: 1},
+			{AccountHash: secondKeys.PublicKey().AccountHash(), Weight: 2},
+		},
+		ActionThresholds: types.ActionThresholds{Deployment: 2, KeyManagement: 3},
+	}
+
+	transaction, err := types.NewTransferTransactionBuilder().
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(firstKeys.PublicKey()).
+		WithTargetPublicKey(secondKeys.PublicKey()).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+
+	require.NoError(t, transaction.Sign(firstKeys))
+	require.NoError(t, transaction.Sign(firstKeys))
+	require.NoError(t, transaction.Sign(strangerKeys))
+	result := account.CheckApprovals(transaction.Hash, transaction.Approvals)
+	assert.Equal(t, uint64(1), result.Weight)
+	assert.False(t, result.DeploymentMet)
+	assert.False(t, result.KeyManagementMet)
+	require.Len(t, result.MissingKeys, 1)
+	assert.Equal(t, secondKeys.PublicKey().AccountHash().Hash, result.MissingKeys[0].AccountHash.Hash)
+	require.Len(t, result.RejectedApprovals, 1)
+	assert.True(t, result.RejectedApprovals[0].Signer.Equals(strangerKeys.PublicKey()))
+
+	require.NoError(t, transaction.Sign(secondKeys))
+	result = account.CheckApprovals(transaction.Hash, transaction.Approvals)
+	assert.Equal(t, uint64(3), result.Weight)
+	assert.True(t, result.DeploymentMet)
+	assert.True(t, result.KeyManagementMet)
+	assert.Empty(t, result.MissingKeys)
+}
+
+func Test_AddressableEntity_CheckApprovals_RejectsInvalidSignature(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+
+	entity := types.AddressableEntity{
+		AssociatedKeys:   []types.AssociatedKey{{AccountHash: keys.PublicKey().AccountHash(), Weight: 1}},
+		ActionThresholds: types.EntityActionThresholds{Deployment: 1, UpgradeManagement: 1, KeyManagement: 1},
+	}
+	signature, err := keys.Sign([]byte("another message"))
+	require.NoError(t, err)
+
+	result := entity.CheckApprovals(key.Hash{}, []types.Approval{{Signer: keys.PublicKey(), Signature: signature}})
+	assert.Zero(t, result.Weight)
+	assert.False(t, result.DeploymentMet)
+	assert.Len(t, result.MissingKeys, 1)
+	assert.Len(t, result.RejectedApprovals, 1)
+}
@@ -0,0 +1,168 @@
+package cl_value
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+func Test_CLValue_Compare(t *testing.T) {
+	mustMarshal := func(v any) clvalue.CLValue {
+		result, err := clvalue.Marshal(v)
+		require.NoError(t, err)
+		return result
+	}
+	one, two := uint64(1), uint64(2)
+	okResult, err := clvalue.NewCLResult(cltype.Bool, cltype.Bool, clvalue.NewCLBool(true), true)
+	require.NoError(t, err)
+	errResult, err := clvalue.NewCLResult(cltype.Bool, cltype.Bool, clvalue.NewCLBool(false), false)
+	require.NoError(t, err)
+	era1, err := key.NewKey("era-1")
+	require.NoError(t, err)
+	era256, err := key.NewKey("era-256")
+	require.NoError(t, err)
+
+	ordered := [][2]clvalue.CLValue{
+		{clvalue.NewCLBool(false), clvalue.NewCLBool(true)},
+		{clvalue.NewCLInt32(-5), clvalue.NewCLInt32(3)},
+		{*clvalue.NewCLUInt512(big.NewInt(255)), *clvalue.NewCLUInt512(big.NewInt(256))},
+		{*clvalue.NewCLString("B"), *clvalue.NewCLString("a")},
+		{*clvalue.NewCLString("ab"), *clvalue.NewCLString("abc")},
+		{mustMarshal((*uint64)(nil)), mustMarshal(&one)},
+		{mustMarshal(&one), mustMarshal(&two)},
+		{mustMarshal([]uint8{1, 2}), mustMarshal([]uint8{1, 2, 0})},
+		{mustMarshal([]uint8{1, 3}), mustMarshal([]uint8{2})},
+		{okResult, errResult},
+		{mustMarshal(map[string]uint8{"a": 1, "b": 1}), mustMarshal(map[string]uint8{"a": 2})},
+		{clvalue.NewCLKey(era1), clvalue.NewCLKey(era256)},
+		{clvalue.NewCLTuple2(clvalue.NewCLBool(true), clvalue.NewCLInt32(1)), clvalue.NewCLTuple2(clvalue.NewCLBool(true), clvalue.NewCLInt32(2))},
+	}
+	for _, pair := range ordered {
+		assert.Equal(t, -1, pair[0].Compare(pair[1]), "%s < %s", pair[0].String(), pair[1].String())
+		assert.Equal(t, 1, pair[1].Compare(pair[0]), "%s > %s", pair[1].String(), pair[0].String())
+		assert.False(t, pair[0].Equals(pair[1]))
+		assert.True(t, pair[0].Equals(pair[0]))
+	}
+
+	// different types are never equal
+	assert.False(t, clvalue.NewCLInt32(1).Equals(*clvalue.NewCLInt64(1)))
+}
+
+func Test_CLValue_EqualsIgnoresMapOrder(t *testing.T) {
+	first := clvalue.NewCLMap(cltype.String, cltype.NewList(cltype.UInt8))
+	second := clvalue.NewCLMap(cltype.String, cltype.NewList(cltype.UInt8))
+	list := func(values ...uint8) clvalue.CLValue {
+		result, err := clvalue.Marshal(values)
+		require.NoError(t, err)
+		result.Type = first.Map.Type.Val
+		result.List.Type = first.Map.Type.Val.(*cltype.List)
+		return result
+	}
+	require.NoError(t, first.Map.Append(*clvalue.NewCLString("a"), list(1)))
+	require.NoError(t, first.Map.Append(*clvalue.NewCLString("b"), list(2, 3)))
+	second.Map.Type.Val = first.Map.Type.Val
+	require.NoError(t, second.Map.Append(*clvalue.NewCLString("b"), list(2, 3)))
+	require.NoError(t, second.Map.Append(*clvalue.NewCLString("a"), list(1)))
+	assert.True(t, first.Equals(second))
+}
+
+func Test_CLValue_ToNative(t *testing.T) {
+	owner, err := key.NewKey("account-hash-bf06bdb1616050cea5862333d1f4787718f1011c95574ba92378419eefeeee59")
+	require.NoError(t, err)
+	value, err := clvalue.Marshal(struct {
+		Balances map[string]*big.Int
+		Owner    key.Key
+		Hash     [2]byte
+	}{
+		Balances: map[string]*big.Int{"a": big.NewInt(1)},
+		Owner:    owner,
+		Hash:     [2]byte{1, 2},
+	})
+	require.NoError(t, err)
+	native, err := value.ToNative()
+	require.NoError(t, err)
+	require.IsType(t, []any{}, native)
+	elements := native.([]any)
+	assert.Equal(t, map[string]any{"a": big.NewInt(1)}, elements[0])
+	assert.Equal(t, owner, elements[1])
+	assert.Equal(t, []byte{1, 2}, elements[2])
+
+	result, err := clvalue.NewCLResult(cltype.Bool, cltype.String, *clvalue.NewCLString("failed"), false)
+	require.NoError(t, err)
+	native, err = result.ToNative()
+	require.NoError(t, err)
+	assert.Equal(t, clvalue.NativeResult{IsSuccess: false, Value: "failed"}, native)
+
+	none, err := clvalue.Marshal((*int32)(nil))
+	require.NoError(t, err)
+	native, err = none.ToNative()
+	require.NoError(t, err)
+	assert.Nil(t, native)
+}
+
+func Test_CLValue_GetValueByType_UnknownType(t *testing.T) {
+	_, err := clvalue.CLValue{}.GetValueByType()
+	assert.True(t, errors.Is(err, clvalue.ErrUnknownCLType))
+	_, err = clvalue.CLValue{Type: &cltype.Dynamic{TypeID: 100, Inner: cltype.Bool}}.GetValueByType()
+	assert.True(t, errors.Is(err, clvalue.ErrUnknownCLType))
+	assert.Equal(t, "", clvalue.CLValue{}.String())
+	assert.Nil(t, clvalue.CLValue{}.Bytes())
+}
+
+func Test_CLValue_Compare_KeysWithIntegers(t *testing.T) {
+	const hash = "94f1805abf61fac1b206d35773f1d1e71be2a162b58acd29fbca6ea5e8e73bed"
+	tests := []struct {
+		name          string
+		less, greater string
+	}{
+		// the integers are little-endian in the bytes, so the bytes of 256 are less than the bytes of 1
+		{name: "era", less: "era-1", greater: "era-256"},
+		{name: "bid addr credit era", less: "bid-addr-04" + hash + "0100000000000000", greater: "bid-addr-04" + hash + "0001000000000000"},
+		{name: "balance hold block time", less: "balance-hold-00" + hash + "0100000000000000", greater: "balance-hold-00" + hash + "0001000000000000"},
+		{name: "message index", less: "message-entity-contract-" + hash + "-" + hash + "-1", greater: "message-entity-contract-" + hash + "-" + hash + "-256"},
+		{name: "message topic", less: "message-topic-entity-contract-" + hash + "-" + hash, greater: "message-entity-contract-" + hash + "-" + hash + "-0"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			less, err := key.NewKey(test.less)
+			require.NoError(t, err)
+			greater, err := key.NewKey(test.greater)
+			require.NoError(t, err)
+			assert.Equal(t, -1, clvalue.NewCLKey(less).Compare(clvalue.NewCLKey(greater)))
+			assert.Equal(t, 1, clvalue.NewCLKey(greater).Compare(clvalue.NewCLKey(less)))
+
+			// the canonical Map is ordered the same way regardless of the order of appending
+			keyMap := clvalue.NewCLMap(cltype.Key, cltype.Bool)
+			require.NoError(t, keyMap.Map.Append(clvalue.NewCLKey(greater), clvalue.NewCLBool(true)))
+			require.NoError(t, keyMap.Map.Append(clvalue.NewCLKey(less), clvalue.NewCLBool(false)))
+			assert.Equal(t, test.less, keyMap.Map.Data()[0].Inner1.Key.String())
+		})
+	}
+}
+
+func Test_CLValue_Compare_WithoutPayload(t *testing.T) {
+	emptyBool := clvalue.CLValue{Type: cltype.Bool}
+	assert.False(t, emptyBool.Equals(clvalue.NewCLBool(true)))
+	assert.Equal(t, -1, emptyBool.Compare(clvalue.NewCLBool(false)))
+	assert.Equal(t, 1, clvalue.NewCLBool(false).Compare(emptyBool))
+	assert.True(t, emptyBool.Equals(clvalue.CLValue{Type: cltype.Bool}))
+
+	optionType := cltype.NewOptionType(cltype.String)
+	assert.True(t, clvalue.CLValue{Type: optionType}.Equals(clvalue.CLValue{Type: optionType}))
+	assert.Equal(t, 0, clvalue.CLValue{Type: cltype.UInt512, UI512: &clvalue.UInt512{}}.Compare(clvalue.CLValue{Type: cltype.UInt512}))
+
+	boolMap := clvalue.NewCLMap(cltype.Bool, cltype.Bool)
+	require.NoError(t, boolMap.Map.Append(clvalue.NewCLBool(true), clvalue.NewCLBool(true)))
+	err := boolMap.Map.Append(clvalue.CLValue{Type: cltype.Bool}, clvalue.NewCLBool(false))
+	assert.True(t, errors.Is(err, clvalue.ErrEmptyCLValue))
+	err = boolMap.Map.Append(clvalue.NewCLBool(false), clvalue.CLValue{Type: cltype.Bool})
+	assert.True(t, errors.Is(err, clvalue.ErrEmptyCLValue))
+	assert.Equal(t, 1, boolMap.Map.Len())
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -77,6 +78,43 @@ func Test_NewMapFromBuffer_IncompleteFormat_ShouldRaiseError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func Test_Map_Append_KeepsCanonicalOrder(t *testing.T) {
+	sourceMap := clvalue.NewCLMap(cltype.String, cltype.UInt8)
+	require.NoError(t, sourceMap.Map.Append(*clvalue.NewCLString("b"), *clvalue.NewCLUint8(1)))
+	require.NoError(t, sourceMap.Map.Append(*clvalue.NewCLString("a"), *clvalue.NewCLUint8(2)))
+	assert.Equal(t, "02000000010000006102010000006201", hex.EncodeToString(sourceMap.Bytes()))
+	assert.Equal(t, `(a="2")(b="1")`, sourceMap.Map.String())
+
+	err := sourceMap.Map.Append(*clvalue.NewCLString("a"), *clvalue.NewCLUint8(3))
+	assert.True(t, errors.Is(err, clvalue.ErrDuplicateMapKey))
+	assert.Equal(t, 2, sourceMap.Map.Len())
+}
+
+func Test_Map_FromBytes_CanonicalOrder(t *testing.T) {
+	mapType := cltype.NewMap(cltype.String, cltype.UInt8)
+	canonical, err := hex.DecodeString("02000000010000006102010000006201")
+	require.NoError(t, err)
+	unsorted, err := hex.DecodeString("02000000010000006201010000006102")
+	require.NoError(t, err)
+	duplicated, err := hex.DecodeString("02000000010000006101010000006102")
+	require.NoError(t, err)
+
+	result, err := clvalue.FromBytesByType(unsorted, mapType)
+	require.NoError(t, err)
+	assert.Equal(t, canonical, result.Bytes())
+	_, err = clvalue.FromBytesByTypeStrict(unsorted, mapType)
+	assert.True(t, errors.Is(err, clvalue.ErrNonCanonicalBytes))
+	_, err = clvalue.FromBytesByTypeStrict(append(canonical, 0), mapType)
+	assert.True(t, errors.Is(err, clvalue.ErrNonCanonicalBytes))
+
+	result, err = clvalue.FromBytesByTypeStrict(canonical, mapType)
+	require.NoError(t, err)
+	assert.Equal(t, canonical, result.Bytes())
+
+	_, err = clvalue.FromBytesByType(duplicated, mapType)
+	assert.True(t, errors.Is(err, clvalue.ErrDuplicateMapKey))
+}
+
 func Test_ArgsWriter_MapFromRawJson(t *testing.T) {
 	source := `{"cl_type":{"Map":{"value":"U32","key":"String"}},"bytes":"01000000030000004f4e4502000000"}`
 	clValue, err := types.ArgsFromRawJson(json.RawMessage(source))
@@ -0,0 +1,231 @@
+package cl_value
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+type marshalPair struct {
+	Name  string
+	Value *big.Int `cl:",u256"`
+}
+
+type marshalSample struct {
+	Flag     bool
+	Count    int32
+	Nonce    uint64
+	Amount   *big.Int `cl:",u128"`
+	Tags     []string
+	Balances map[string]uint64
+	Memo     *uint64
+	Empty    *string
+	Hash     [32]byte
+	Pair     marshalPair
+	Owner    key.Key
+	Purse    key.URef
+	Signer   keypair.PublicKey
+	Unit     struct{}
+	Raw      clvalue.CLValue
+}
+
+func Test_Marshal_MatchesConstructors(t *testing.T) {
+	value, err := clvalue.Marshal(map[string]int32{"ABC": 10})
+	require.NoError(t, err)
+	expected := clvalue.NewCLMap(cltype.String, cltype.Int32)
+	require.NoError(t, expected.Map.Append(*clvalue.NewCLString("ABC"), clvalue.NewCLInt32(10)))
+	assertSameBytes(t, expected, value)
+
+	value, err = clvalue.Marshal(big.NewInt(2500000000))
+	require.NoError(t, err)
+	assertSameBytes(t, *clvalue.NewCLUInt512(big.NewInt(2500000000)), value)
+
+	value, err = clvalue.Marshal([][]uint8{{1, 2}, {}})
+	require.NoError(t, err)
+	assert.Equal(t, "List", value.Type.Name())
+	assert.Equal(t, "(List of (List of U8))", value.Type.String())
+
+	memo := uint64(7)
+	value, err = clvalue.Marshal(&memo)
+	require.NoError(t, err)
+	assertSameBytes(t, clvalue.NewCLOption(*clvalue.NewCLUInt64(7)), value)
+}
+
+func Test_Marshal_UnmarshalRoundTrip(t *testing.T) {
+	owner, err := key.NewKey("account-hash-bf06bdb1616050cea5862333d1f4787718f1011c95574ba92378419eefeeee59")
+	require.NoError(t, err)
+	purse, err := key.NewURef("uref-7b12008bb757ee32caefb3f7a1f77d9f659ee7a4e21ad4950c4e0294000492eb-007")
+	require.NoError(t, err)
+	privateKey, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+	memo := uint64(42)
+	source := marshalSample{
+		Flag:     true,
+		Count:    -5,
+		Nonce:    1 << 60,
+		Amount:   new(big.Int).Lsh(big.NewInt(1), 100),
+		Tags:     []string{"a", "b"},
+		Balances: map[string]uint64{"z": 1, "a": 2},
+		Memo:     &memo,
+		Hash:     [32]byte{1, 2, 3},
+		Pair:     marshalPair{Name: "pair", Value: big.NewInt(9)},
+		Owner:    owner,
+		Purse:    purse,
+		Signer:   privateKey.PublicKey(),
+		Raw:      *clvalue.NewCLString("raw"),
+	}
+
+	fields, err := clvalue.MarshalFields(source)
+	require.NoError(t, err)
+	require.Len(t, fields, 15)
+	assert.Equal(t, "Amount", fields[3].Name)
+	assert.Equal(t, cltype.UInt128, fields[3].Value.Type)
+	assert.Equal(t, "Tuple2 (String, U256)", fields[9].Value.Type.String())
+
+	var result marshalSample
+	for _, field := range fields {
+		// every field is marshalled and decoded from bytes on its own
+		data, err := clvalue.ToBytesWithType(field.Value)
+		require.NoError(t, err)
+		decoded, _, err := clvalue.FromBytes(data)
+		require.NoError(t, err)
+		fieldValue, err := clvalue.Marshal(decoded)
+		require.NoError(t, err)
+		switch field.Name {
+		case "Flag":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Flag))
+		case "Count":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Count))
+		case "Nonce":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Nonce))
+		case "Amount":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Amount))
+		case "Tags":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Tags))
+		case "Balances":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Balances))
+		case "Memo":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Memo))
+		case "Empty":
+			result.Empty = new(string)
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Empty))
+		case "Hash":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Hash))
+		case "Pair":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Pair))
+		case "Owner":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Owner))
+		case "Purse":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Purse))
+		case "Signer":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Signer))
+		case "Unit":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Unit))
+		case "Raw":
+			require.NoError(t, clvalue.Unmarshal(fieldValue, &result.Raw))
+		}
+	}
+
+	assert.Equal(t, source.Flag, result.Flag)
+	assert.Equal(t, source.Count, result.Count)
+	assert.Equal(t, source.Nonce, result.Nonce)
+	assert.Equal(t, 0, source.Amount.Cmp(result.Amount))
+	assert.Equal(t, source.Tags, result.Tags)
+	assert.Equal(t, source.Balances, result.Balances)
+	assert.Equal(t, memo, *result.Memo)
+	assert.Nil(t, result.Empty)
+	assert.Equal(t, source.Hash, result.Hash)
+	assert.Equal(t, "pair", result.Pair.Name)
+	assert.Equal(t, int64(9), result.Pair.Value.Int64())
+	assert.Equal(t, owner.String(), result.Owner.String())
+	assert.Equal(t, purse.String(), result.Purse.String())
+	assert.True(t, source.Signer.Equals(result.Signer))
+	assert.Equal(t, "raw", result.Raw.String())
+}
+
+func Test_Marshal_SortsMapKeys(t *testing.T) {
+	first, err := clvalue.Marshal(map[string]uint8{"b": 2, "a": 1, "c": 3})
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		next, err := clvalue.Marshal(map[string]uint8{"c": 3, "b": 2, "a": 1})
+		require.NoError(t, err)
+		assert.Equal(t, first.Bytes(), next.Bytes())
+	}
+	assert.Equal(t, "a", first.Map.Data()[0].Inner1.String())
+}
+
+func Test_Unmarshal_Errors(t *testing.T) {
+	var small uint8
+	err := clvalue.Unmarshal(*clvalue.NewCLUInt32(300), &small)
+	assert.True(t, errors.Is(err, clvalue.ErrValueOverflow))
+
+	var text string
+	err = clvalue.Unmarshal(clvalue.NewCLBool(true), &text)
+	assert.True(t, errors.Is(err, clvalue.ErrCLTypeMismatch))
+
+	var hash [32]byte
+	err = clvalue.Unmarshal(clvalue.NewCLByteArray([]byte{1, 2}), &hash)
+	assert.True(t, errors.Is(err, clvalue.ErrCLTypeMismatch))
+
+	err = clvalue.Unmarshal(clvalue.NewCLBool(true), text)
+	assert.True(t, errors.Is(err, clvalue.ErrInvalidUnmarshalType))
+
+	_, err = clvalue.Marshal(float64(1))
+	assert.True(t, errors.Is(err, clvalue.ErrUnsupportedGoType))
+
+	_, err = clvalue.Marshal(struct {
+		Amount *big.Int `cl:",u128"`
+	}{Amount: new(big.Int).Lsh(big.NewInt(1), 128)})
+	assert.True(t, errors.Is(err, clvalue.ErrValueOverflow))
+}
+
+func Test_NewArgsFromStruct(t *testing.T) {
+	target, err := key.NewKey("account-hash-bf06bdb1616050cea5862333d1f4787718f1011c95574ba92378419eefeeee59")
+	require.NoError(t, err)
+	args, err := types.NewArgsFromStruct(struct {
+		Amount   uint64   `cl:"amount,u512"`
+		Target   key.Key  `cl:"target"`
+		ID       *uint64  `cl:"id"`
+		Optional *string  `cl:"optional,omitempty"`
+		Ignored  string   `cl:"-"`
+		Bonus    *big.Int `cl:"bonus,u256"`
+	}{Amount: 2500000000, Target: target, Bonus: big.NewInt(1)})
+	require.NoError(t, err)
+	require.Len(t, *args, 4)
+
+	expected := &types.Args{}
+	expected.AddArgument("amount", *clvalue.NewCLUInt512(big.NewInt(2500000000)))
+	expected.AddArgument("target", clvalue.NewCLKey(target))
+	expected.AddArgument("id", clvalue.CLValue{Type: cltype.NewOptionType(cltype.UInt64), Option: &clvalue.Option{Type: cltype.NewOptionType(cltype.UInt64)}})
+	expected.AddArgument("bonus", *clvalue.NewCLUInt256(big.NewInt(1)))
+	expectedBytes, err := expected.Bytes()
+	require.NoError(t, err)
+	argsBytes, err := args.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, expectedBytes, argsBytes)
+
+	var amount uint64
+	require.NoError(t, args.Unmarshal("amount", &amount))
+	assert.Equal(t, uint64(2500000000), amount)
+	var id *uint64
+	require.NoError(t, args.Unmarshal("id", &id))
+	assert.Nil(t, id)
+	assert.True(t, errors.Is(args.Unmarshal("optional", &id), types.ErrArgumentNotFound))
+}
+
+func assertSameBytes(t *testing.T, expected, actual clvalue.CLValue) {
+	expectedBytes, err := clvalue.ToBytesWithType(expected)
+	require.NoError(t, err)
+	actualBytes, err := clvalue.ToBytesWithType(actual)
+	require.NoError(t, err)
+	assert.Equal(t, expectedBytes, actualBytes)
+}
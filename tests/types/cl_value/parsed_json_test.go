@@ -0,0 +1,129 @@
+package cl_value
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+)
+
+// The parsed values in the fixtures are returned by the node, every decodable value has to be rendered the same way.
+func Test_ToParsedJSON_MatchesNode(t *testing.T) {
+	files := []string{
+		"../../data/transaction/get_transaction_with_invalid_args.json",
+		"../../data/transaction/get_transaction_install_contract.json",
+		"../../data/transaction/get_transaction_native_entry_point.json",
+		"../../data/transform/write_clvalue_v1.json",
+	}
+	checked := 0
+	for _, file := range files {
+		source, err := os.ReadFile(file)
+		require.NoError(t, err)
+		var data any
+		require.NoError(t, json.Unmarshal(source, &data))
+		for _, one := range collectParsedValues(data) {
+			clType, err := cltype.FromRawJson(one.CLType)
+			require.NoError(t, err)
+			value, err := clvalue.FromBytesByType(one.Bytes, clType)
+			if err != nil {
+				// some of the fixtures contain invalid bytes on purpose
+				continue
+			}
+			parsed, err := value.ToParsedJSON()
+			require.NoError(t, err)
+			assert.JSONEq(t, string(one.Parsed), string(parsed), "%s: %s", file, one.CLType)
+
+			restored, err := clvalue.NewCLValueFromParsed(clType, one.Parsed)
+			if errors.Is(err, clvalue.ErrAmbiguousParsedValue) {
+				continue
+			}
+			require.NoError(t, err, "%s: %s", file, one.CLType)
+			assert.Equal(t, hex.EncodeToString(one.Bytes), hex.EncodeToString(restored.Bytes()), "%s: %s", file, one.CLType)
+			checked++
+		}
+	}
+	assert.Greater(t, checked, 20)
+}
+
+func Test_ToParsedJSON_Nested(t *testing.T) {
+	one := uint64(1)
+	value, err := clvalue.Marshal(map[string][]*uint64{"a": {&one, nil}})
+	require.NoError(t, err)
+	parsed, err := value.ToParsedJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"key": "a", "value": [1, null]}]`, string(parsed))
+
+	result, err := clvalue.NewCLResult(cltype.String, cltype.UInt32, *clvalue.NewCLUInt32(3), false)
+	require.NoError(t, err)
+	parsed, err = result.ToParsedJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Err": 3}`, string(parsed))
+
+	list := clvalue.NewCLList(cltype.Any)
+	list.List.Append(clvalue.NewCLAny([]byte{1}))
+	parsed, err = list.ToParsedJSON()
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(parsed))
+
+	args := &types.Args{}
+	args.AddArgument("amount", *clvalue.NewCLUInt512(big.NewInt(2500000000)))
+	parsed, err = (*args)[0].Argument().Parsed()
+	require.NoError(t, err)
+	assert.Equal(t, `"2500000000"`, string(parsed))
+}
+
+func Test_NewCLValueFromParsedJSON(t *testing.T) {
+	value, err := clvalue.NewCLValueFromParsedJSON(json.RawMessage(`{"cl_type": {"Tuple3": ["I32", "String", {"Option": "U512"}]}, "parsed": [555, "ABC", "12"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "2b0200000300000041424301010c", hex.EncodeToString(value.Bytes()))
+
+	// the keys returned by the nodes of the version 1.x are wrapped into the object of the variant
+	value, err = clvalue.NewCLValueFromParsedJSON(json.RawMessage(`{"cl_type": "Key", "parsed": {"Account": "account-hash-1e3de90818d5ad5298006eefaee309b6a45833355efedfba776a2003c408c8cd"}}`))
+	require.NoError(t, err)
+	parsed, err := value.ToParsedJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"account-hash-1e3de90818d5ad5298006eefaee309b6a45833355efedfba776a2003c408c8cd"`, string(parsed))
+
+	_, err = clvalue.NewCLValueFromParsed(cltype.NewOptionType(cltype.Unit), json.RawMessage("null"))
+	assert.True(t, errors.Is(err, clvalue.ErrAmbiguousParsedValue))
+	_, err = clvalue.NewCLValueFromParsed(cltype.NewList(cltype.NewOptionType(cltype.NewOptionType(cltype.Bool))), json.RawMessage("[null]"))
+	assert.True(t, errors.Is(err, clvalue.ErrAmbiguousParsedValue))
+}
+
+type parsedFixture struct {
+	CLType json.RawMessage
+	Bytes  []byte
+	Parsed json.RawMessage
+}
+
+func collectParsedValues(data any) []parsedFixture {
+	var result []parsedFixture
+	switch source := data.(type) {
+	case map[string]any:
+		clType, hasType := source["cl_type"]
+		parsed, hasParsed := source["parsed"]
+		if hasType && hasParsed {
+			typeJSON, _ := json.Marshal(clType)
+			parsedJSON, _ := json.Marshal(parsed)
+			bytes, _ := hex.DecodeString(source["bytes"].(string))
+			result = append(result, parsedFixture{CLType: typeJSON, Bytes: bytes, Parsed: parsedJSON})
+		}
+		for _, one := range source {
+			result = append(result, collectParsedValues(one)...)
+		}
+	case []any:
+		for _, one := range source {
+			result = append(result, collectParsedValues(one)...)
+		}
+	}
+	return result
+}
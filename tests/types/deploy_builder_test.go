@@ -0,0 +1,99 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+func Test_ContractCallDeployBuilder_SameBodyHashAsManualDeploy(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	contractHash, err := key.NewContract("8ff7a1c49017400013dcf78305343fa07c31b04292b7928845ed59764e1ee512")
+	require.NoError(t, err)
+	version := uint32(2)
+
+	args := &types.Args{}
+	args.AddArgument("amount", *clvalue.NewCLUInt256(big.NewInt(2500000000)))
+	deploy, err := types.NewContractCallDeployBuilder().
+		WithPackageHash(contractHash, &version).
+		WithEntryPoint("get_message").
+		WithArgs(args).
+		WithPaymentAmount(big.NewInt(3000000000)).
+		WithChainName("casper-net-1").
+		WithAccount(keys.PublicKey()).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "3b88d9461f8f16e6975db372fffaa4198bbd5f818fb95c262eecdf3d22b77918", deploy.Header.BodyHash.ToHex())
+	require.NoError(t, deploy.Sign(keys))
+	assert.NoError(t, deploy.Validate())
+}
+
+func Test_DeployBuilders_Build(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+	validator, err := casper.NewPublicKey("0106ed45915392c02b37136618372ac8dde8e0e3b8ee6190b2ca6db539b354ede4")
+	require.NoError(t, err)
+	auction, err := key.NewContract("93d923e336b20a4c4ca14d592b60e5bd3fe330775618290104f9beb326db7ae2")
+	require.NoError(t, err)
+	amount := big.NewInt(500000000000)
+	payment := big.NewInt(2500000000)
+
+	builders := map[string]func() (*types.Deploy, error){
+		"transfer": types.NewTransferDeployBuilder().WithTargetPublicKey(validator).WithAmount(amount).WithID(1).
+			WithPaymentAmount(payment).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"add_bid": types.NewAddBidDeployBuilder(auction).WithPublicKey(pubKey).WithDelegationRate(10).WithAmount(amount).
+			WithPaymentAmount(payment).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"withdraw_bid": types.NewWithdrawBidDeployBuilder(auction).WithPublicKey(pubKey).WithAmount(amount).
+			WithPaymentAmount(payment).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"delegate": types.NewDelegateDeployBuilder(auction).WithDelegator(pubKey).WithValidator(validator).WithAmount(amount).
+			WithPaymentAmount(payment).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"undelegate": types.NewUndelegateDeployBuilder(auction).WithDelegator(pubKey).WithValidator(validator).WithAmount(amount).
+			WithPaymentAmount(payment).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"redelegate": types.NewRedelegateDeployBuilder(auction).WithDelegator(pubKey).WithValidator(validator).WithNewValidator(pubKey).WithAmount(amount).
+			WithPaymentAmount(payment).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"contract_by_name": types.NewContractCallDeployBuilder().WithContractName("cep18").WithEntryPoint("transfer").
+			WithPaymentAmount(payment).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"session_file": types.NewSessionDeployBuilder().WithModuleFile("../data/wasm/cep18-rc3.wasm").
+			WithPaymentAmount(payment).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+	}
+	for name, build := range builders {
+		t.Run(name, func(t *testing.T) {
+			deploy, err := build()
+			require.NoError(t, err)
+			require.NoError(t, deploy.Sign(keys))
+			assert.NoError(t, deploy.Validate())
+		})
+	}
+}
+
+func Test_DeployBuilders_Validation(t *testing.T) {
+	pubKey, err := casper.NewPublicKey("0106ed45915392c02b37136618372ac8dde8e0e3b8ee6190b2ca6db539b354ede4")
+	require.NoError(t, err)
+	auction, err := key.NewContract("93d923e336b20a4c4ca14d592b60e5bd3fe330775618290104f9beb326db7ae2")
+	require.NoError(t, err)
+
+	_, err = types.NewTransferDeployBuilder().WithTargetPublicKey(pubKey).WithAmount(big.NewInt(1)).
+		WithChainName("casper-net-1").WithAccount(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrMissingTransactionArgument)
+
+	_, err = types.NewTransferDeployBuilder().WithTargetPublicKey(pubKey).WithAmount(big.NewInt(1)).
+		WithPaymentAmount(big.NewInt(1)).WithChainName("casper-net-1").Build()
+	assert.ErrorIs(t, err, types.ErrMissingDeployAccount)
+
+	_, err = types.NewAddBidDeployBuilder(auction).WithPublicKey(pubKey).WithAmount(big.NewInt(1)).
+		WithPaymentAmount(big.NewInt(1)).WithChainName("casper-net-1").WithAccount(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrMissingTransactionArgument)
+
+	_, err = types.NewSessionDeployBuilder().WithModuleFile("../data/wasm/missing.wasm").
+		WithPaymentAmount(big.NewInt(1)).WithChainName("casper-net-1").WithAccount(pubKey).Build()
+	assert.Error(t, err)
+}
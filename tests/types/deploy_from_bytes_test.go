@@ -0,0 +1,119 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+func Test_Deploy_FromBytes_RoundTripFixtures(t *testing.T) {
+	fixtures := []string{
+		"../data/deploy/deploy_with_stored_contract_by_name.json",
+		"../data/deploy/deploy_with_stored_contract_by_hash.json",
+		"../data/deploy/deploy_with_stored_contract_by_hash_with_version.json",
+		"../data/deploy/deploy_with_transfer.json",
+		"../data/deploy/deploy_valid_for_execution.json",
+	}
+	for _, fixture := range fixtures {
+		t.Run(fixture, func(t *testing.T) {
+			data, err := os.ReadFile(fixture)
+			require.NoError(t, err)
+			var deploy types.Deploy
+			require.NoError(t, json.Unmarshal(data, &deploy))
+
+			encoded, err := deploy.Bytes()
+			require.NoError(t, err)
+			decoded, err := types.NewDeployFromBytes(encoded)
+			require.NoError(t, err)
+
+			reEncoded, err := decoded.Bytes()
+			require.NoError(t, err)
+			assert.Equal(t, encoded, reEncoded)
+			assert.Equal(t, deploy.Hash, decoded.Hash)
+			assert.Equal(t, deploy.Header.ChainName, decoded.Header.ChainName)
+			assert.Len(t, decoded.Approvals, len(deploy.Approvals))
+			assert.NoError(t, decoded.Validate())
+		})
+	}
+}
+
+func Test_Deploy_FromBytes_AllSessionVariants(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+	contractHash, err := key.NewContract("8ff7a1c49017400013dcf78305343fa07c31b04292b7928845ed59764e1ee512")
+	require.NoError(t, err)
+	version := uint32(3)
+	args := &types.Args{}
+	args.AddArgument("amount", *clvalue.NewCLUInt256(big.NewInt(2500000000))).
+		AddArgument("recipient", clvalue.NewCLOption(*clvalue.NewCLString("alice")))
+
+	builders := map[string]func() (*types.Deploy, error){
+		"module_bytes": types.NewSessionDeployBuilder().WithModuleFile("../data/wasm/empty.wasm").WithArgs(args).
+			WithPaymentAmount(big.NewInt(1)).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"by_hash": types.NewContractCallDeployBuilder().WithContractHash(contractHash).WithEntryPoint("call").WithArgs(args).
+			WithPaymentAmount(big.NewInt(1)).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"by_name": types.NewContractCallDeployBuilder().WithContractName("cep18").WithEntryPoint("call").WithArgs(args).
+			WithPaymentAmount(big.NewInt(1)).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"versioned_by_hash": types.NewContractCallDeployBuilder().WithPackageHash(contractHash, &version).WithEntryPoint("call").
+			WithPaymentAmount(big.NewInt(1)).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+		"versioned_by_name": types.NewContractCallDeployBuilder().WithPackageName("cep18_package", nil).WithEntryPoint("call").
+			WithPaymentAmount(big.NewInt(1)).WithChainName("casper-net-1").WithAccount(pubKey).WithDependencies(contractHash.Hash).Build,
+		"transfer": types.NewTransferDeployBuilder().WithTargetPublicKey(pubKey).WithAmount(big.NewInt(2500000000)).WithID(7).
+			WithPaymentAmount(big.NewInt(1)).WithChainName("casper-net-1").WithAccount(pubKey).Build,
+	}
+	for name, build := range builders {
+		t.Run(name, func(t *testing.T) {
+			deploy, err := build()
+			require.NoError(t, err)
+			require.NoError(t, deploy.Sign(keys))
+			encoded, err := deploy.Bytes()
+			require.NoError(t, err)
+
+			decoded, err := types.NewDeployFromBytes(encoded)
+			require.NoError(t, err)
+			reEncoded, err := decoded.Bytes()
+			require.NoError(t, err)
+			assert.Equal(t, encoded, reEncoded)
+			assert.NoError(t, decoded.Validate())
+		})
+	}
+}
+
+func Test_Deploy_FromBytes_Errors(t *testing.T) {
+	data, err := os.ReadFile("../data/deploy/deploy_with_transfer.json")
+	require.NoError(t, err)
+	var deploy types.Deploy
+	require.NoError(t, json.Unmarshal(data, &deploy))
+	encoded, err := deploy.Bytes()
+	require.NoError(t, err)
+
+	_, err = types.NewDeployFromBytes(append(encoded, 0))
+	assert.ErrorIs(t, err, types.ErrTrailingBytes)
+
+	_, err = types.NewDeployFromBytes(encoded[:len(encoded)-10])
+	var decodingErr *types.DecodingError
+	require.ErrorAs(t, err, &decodingErr)
+	assert.Equal(t, "approvals[0].signature", decodingErr.Field)
+
+	mismatched := append([]byte{}, encoded...)
+	signatureOffset := len(encoded) - len(deploy.Approvals[0].Signature)
+	mismatched[signatureOffset] = 3 - mismatched[signatureOffset]
+	_, err = types.NewDeployFromBytes(mismatched)
+	require.ErrorAs(t, err, &decodingErr)
+	assert.Equal(t, "approvals[0].signature", decodingErr.Field)
+	assert.ErrorIs(t, err, types.ErrInvalidApprovalSignature)
+
+	_, err = types.NewDeployFromBytes(encoded[:len(deploy.Header.Account.Bytes())+4])
+	require.ErrorAs(t, err, &decodingErr)
+	assert.Equal(t, "header.timestamp", decodingErr.Field)
+}
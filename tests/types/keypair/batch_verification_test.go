@@ -0,0 +1,45 @@
+package keypair
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+func Test_VerifyBatch_ReportsFailedIndexes(t *testing.T) {
+	items := make([]keypair.SignatureVerification, 0, 40)
+	for i := 0; i < 40; i++ {
+		algorithm := keypair.ED25519
+		if i%3 == 0 {
+			algorithm = keypair.SECP256K1
+		}
+		privateKey, err := keypair.GeneratePrivateKey(algorithm)
+		require.NoError(t, err)
+		message := []byte(fmt.Sprintf("message %d", i))
+		signature, err := privateKey.Sign(message)
+		require.NoError(t, err)
+		items = append(items, keypair.SignatureVerification{PublicKey: privateKey.PublicKey(), Message: message, Signature: signature})
+	}
+
+	for _, workers := range []int{1, 4, 64} {
+		assert.NoError(t, keypair.VerifyBatchWithWorkers(items, workers))
+	}
+	assert.NoError(t, keypair.VerifyBatch(items[:3]))
+
+	items[3].Message = []byte("another message")
+	items[17].Signature = items[18].Signature
+	items[39].PublicKey = items[0].PublicKey
+	for _, workers := range []int{1, 4, 64} {
+		err := keypair.VerifyBatchWithWorkers(items, workers)
+		var batchErr *keypair.BatchVerificationError
+		require.True(t, errors.As(err, &batchErr))
+		assert.Equal(t, []int{3, 17, 39}, batchErr.Failed)
+		assert.True(t, errors.Is(err, keypair.ErrInvalidSignature))
+	}
+	assert.NoError(t, keypair.VerifyBatch(nil))
+}
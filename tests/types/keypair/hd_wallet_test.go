@@ -0,0 +1,210 @@
+package keypair
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+// Test vectors of the BIP-39 reference implementation, the seeds are derived with the "TREZOR" passphrase.
+func Test_Mnemonic_ReferenceVectors(t *testing.T) {
+	tests := []struct {
+		entropy  string
+		mnemonic string
+		seed     string
+	}{
+		{
+			entropy:  "00000000000000000000000000000000",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+			seed:     "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+		},
+		{
+			entropy:  "c0ba5a8e914111210f2bd131f3d5e08d",
+			mnemonic: "scheme spot photo card baby mountain device kick cradle pact join borrow",
+		},
+		{
+			entropy:  "68a79eaca2324873eacc50cb9c6eca8cc68ea5d936f98787c60c7ebc74e6ce7c",
+			mnemonic: "hamster diagram private dutch cause delay private meat slide toddler razor book happy fancy gospel tennis maple dilemma loan word shrug inflict delay length",
+		},
+		{
+			entropy:  "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.mnemonic, func(t *testing.T) {
+			entropy, err := hex.DecodeString(test.entropy)
+			require.NoError(t, err)
+			mnemonic, err := keypair.NewMnemonicFromEntropy(entropy)
+			require.NoError(t, err)
+			assert.Equal(t, test.mnemonic, mnemonic)
+
+			decoded, err := keypair.MnemonicToEntropy(mnemonic)
+			require.NoError(t, err)
+			assert.Equal(t, test.entropy, hex.EncodeToString(decoded))
+
+			if test.seed != "" {
+				seed, err := keypair.NewSeedFromMnemonic(mnemonic, "TREZOR")
+				require.NoError(t, err)
+				assert.Equal(t, test.seed, hex.EncodeToString(seed))
+			}
+		})
+	}
+}
+
+func Test_Mnemonic_GenerateValidate(t *testing.T) {
+	for _, words := range []int{12, 15, 18, 21, 24} {
+		mnemonic, err := keypair.NewMnemonic(words)
+		require.NoError(t, err)
+		assert.Len(t, strings.Fields(mnemonic), words)
+		assert.NoError(t, keypair.ValidateMnemonic(mnemonic))
+	}
+	_, err := keypair.NewMnemonic(13)
+	assert.True(t, errors.Is(err, keypair.ErrInvalidMnemonicLength))
+
+	valid := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+	assert.True(t, errors.Is(keypair.ValidateMnemonic(strings.Replace(valid, "yellow", "year", 1)), keypair.ErrInvalidMnemonicChecksum))
+	assert.True(t, errors.Is(keypair.ValidateMnemonic(strings.Replace(valid, "legal", "casper", 1)), keypair.ErrUnknownMnemonicWord))
+	assert.True(t, errors.Is(keypair.ValidateMnemonic("legal winner thank"), keypair.ErrInvalidMnemonicLength))
+}
+
+// BIP-32 test vector 1.
+func Test_ExtendedKey_SECP256K1ReferenceVector(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+	tests := []struct {
+		path      string
+		chainCode string
+		key       string
+	}{
+		{"m", "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{"m/0'", "47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1", "2a7857631386ba23dacac34180dd1983734e444fdbf774041578e9b6adb37c19", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{"m/0'/1/2'", "04466b9cc8e161e966409ca52986c584f07e9dc81f735db683c3ff6ec7b1503f", "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+		{"m/0'/1/2'/2", "cfb71883f01676f587d023cc53a35bc7f88f724b1f8c2892ac1275ac822a3edd", "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"},
+		{"m/0'/1/2'/2/1000000000", "c783e67b921d2beb8f6b389cc646d7263b4145701dadd2161548a8b078e65e9e", "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	}
+	master, err := keypair.NewMasterKey(seed, keypair.SECP256K1)
+	require.NoError(t, err)
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			path, err := keypair.ParseDerivationPath(test.path)
+			require.NoError(t, err)
+			extended, err := master.Derive(path)
+			require.NoError(t, err)
+			assert.Equal(t, test.chainCode, hex.EncodeToString(extended.ChainCode))
+			assert.Equal(t, test.key, hex.EncodeToString(extended.Key))
+		})
+	}
+
+	privateKey, err := keypair.NewPrivateKeyFromSeed(seed, "m/0h", keypair.SECP256K1)
+	require.NoError(t, err)
+	assert.Equal(t, "02035a784662a4a20a65bf6aab9ae98a6c068a81c52e4b032c0fb5400c706cfccc56", privateKey.PublicKey().ToHex())
+}
+
+// SLIP-10 ED25519 test vector 1.
+func Test_ExtendedKey_ED25519ReferenceVector(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+	tests := []struct {
+		path      string
+		chainCode string
+		key       string
+	}{
+		{"m", "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{"m/0'", "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{"m/0'/1'", "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		{"m/0'/1'/2'", "2e69929e00b5ab250f49c3fb1c12f252de4fed2c1db88387094a0f8c4c9ccd6c", "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"},
+	}
+	master, err := keypair.NewMasterKey(seed, keypair.ED25519)
+	require.NoError(t, err)
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			path, err := keypair.ParseDerivationPath(test.path)
+			require.NoError(t, err)
+			extended, err := master.Derive(path)
+			require.NoError(t, err)
+			assert.Equal(t, test.chainCode, hex.EncodeToString(extended.ChainCode))
+			assert.Equal(t, test.key, hex.EncodeToString(extended.Key))
+		})
+	}
+
+	privateKey, err := keypair.NewPrivateKeyFromSeed(seed, "m/0'", keypair.ED25519)
+	require.NoError(t, err)
+	assert.Equal(t, "018c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c", privateKey.PublicKey().ToHex())
+
+	_, err = master.Derive(keypair.DerivationPath{0})
+	assert.True(t, errors.Is(err, keypair.ErrHardenedDerivationOnly))
+}
+
+func Test_CasperDerivationPath(t *testing.T) {
+	assert.Equal(t, "m/44'/506'/0'/0/3", keypair.CasperDerivationPath(keypair.SECP256K1, 0, 3).String())
+	assert.Equal(t, "m/44'/506'/1'/0'/3'", keypair.CasperDerivationPath(keypair.ED25519, 1, 3).String())
+
+	path, err := keypair.ParseDerivationPath("m/44'/506'/0'/0/3")
+	require.NoError(t, err)
+	assert.Equal(t, keypair.CasperDerivationPath(keypair.SECP256K1, 0, 3), path)
+
+	for _, invalid := range []string{"", "44'/506'", "m/", "m/a", "m/1''", "m/2147483648"} {
+		_, err = keypair.ParseDerivationPath(invalid)
+		assert.True(t, errors.Is(err, keypair.ErrInvalidDerivationPath), invalid)
+	}
+}
+
+func Test_NewPrivateKeyFromMnemonic_DerivesAccounts(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	for _, algorithm := range []keypair.KeyAlgorithm{keypair.ED25519, keypair.SECP256K1} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			first, err := keypair.NewPrivateKeyFromMnemonic(mnemonic, "", keypair.CasperDerivationPath(algorithm, 0, 0).String(), algorithm)
+			require.NoError(t, err)
+			again, err := keypair.NewPrivateKeyFromMnemonic(mnemonic, "", keypair.CasperDerivationPath(algorithm, 0, 0).String(), algorithm)
+			require.NoError(t, err)
+			second, err := keypair.NewPrivateKeyFromMnemonic(mnemonic, "", keypair.CasperDerivationPath(algorithm, 0, 1).String(), algorithm)
+			require.NoError(t, err)
+			withPassphrase, err := keypair.NewPrivateKeyFromMnemonic(mnemonic, "casper", keypair.CasperDerivationPath(algorithm, 0, 0).String(), algorithm)
+			require.NoError(t, err)
+
+			assert.Equal(t, algorithm, first.Algorithm())
+			assert.True(t, first.PublicKey().Equals(again.PublicKey()))
+			assert.False(t, first.PublicKey().Equals(second.PublicKey()))
+			assert.False(t, first.PublicKey().Equals(withPassphrase.PublicKey()))
+
+			signature, err := first.Sign([]byte("message"))
+			require.NoError(t, err)
+			assert.NoError(t, first.PublicKey().VerifySignature([]byte("message"), signature))
+		})
+	}
+}
+
+// The keys of the first account derived from the BIP-39 reference mnemonic without a passphrase. The vectors are computed
+// by an independent BIP-39, BIP-32 and SLIP-10 implementation, checked against the reference vectors of the specifications above.
+func Test_NewPrivateKeyFromMnemonic_KnownKeys(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	tests := []struct {
+		algorithm keypair.KeyAlgorithm
+		path      string
+		publicKey string
+	}{
+		{keypair.SECP256K1, "m/44'/506'/0'/0/0", "020357f9e27d8125932c5e6fd52babb1a114bc89363f2f56c7860bb594f74523342b"},
+		{keypair.ED25519, "m/44'/506'/0'/0'/0'", "016a1585d8197fc14b1d8cc05d5351e5ba04810d466158a050494c799b776ff819"},
+	}
+	for _, test := range tests {
+		t.Run(test.algorithm.String(), func(t *testing.T) {
+			assert.Equal(t, test.path, keypair.CasperDerivationPath(test.algorithm, 0, 0).String())
+			privateKey, err := keypair.NewPrivateKeyFromMnemonic(mnemonic, "", test.path, test.algorithm)
+			require.NoError(t, err)
+			assert.Equal(t, test.publicKey, privateKey.PublicKey().ToHex())
+		})
+	}
+}
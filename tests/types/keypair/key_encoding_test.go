@@ -0,0 +1,154 @@
+package keypair
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+func Test_KeyEncoding_RoundTrip(t *testing.T) {
+	for _, algorithm := range []keypair.KeyAlgorithm{keypair.ED25519, keypair.SECP256K1} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			privateKey, err := keypair.GeneratePrivateKey(algorithm)
+			require.NoError(t, err)
+			publicKey := privateKey.PublicKey()
+			assert.Equal(t, algorithm, publicKey.Algorithm())
+
+			publicPem, err := publicKey.ToPem()
+			require.NoError(t, err)
+			publicDer, err := publicKey.ToDer()
+			require.NoError(t, err)
+			sources := [][]byte{
+				publicPem,
+				publicDer,
+				publicKey.Bytes(),
+				publicKey.RawBytes(),
+				publicKey.UncompressedBytes(),
+				[]byte(publicKey.ToHex() + "\n"),
+				[]byte(hex.EncodeToString(publicKey.RawBytes())),
+			}
+			for _, source := range sources {
+				parsed, err := keypair.ParsePublicKey(source)
+				require.NoError(t, err)
+				assert.True(t, publicKey.Equals(parsed))
+			}
+
+			privatePem, err := privateKey.ToPem()
+			require.NoError(t, err)
+			privateDer, err := privateKey.ToDer()
+			require.NoError(t, err)
+			for _, source := range [][]byte{privatePem, privateDer} {
+				parsed, err := keypair.ParsePrivateKey(source)
+				require.NoError(t, err)
+				assert.Equal(t, algorithm, parsed.Algorithm())
+				assert.True(t, publicKey.Equals(parsed.PublicKey()))
+			}
+
+			fromRaw, err := keypair.NewPrivateKeyFromRaw(privateKey.RawBytes(), algorithm)
+			require.NoError(t, err)
+			assert.True(t, publicKey.Equals(fromRaw.PublicKey()))
+		})
+	}
+}
+
+func Test_KeyEncoding_SECP256K1Compression(t *testing.T) {
+	privateKey, err := keypair.ParsePrivateKey(readKeyFile(t, "account_test_SECP_secret_key.pem"))
+	require.NoError(t, err)
+	publicKey := privateKey.PublicKey()
+
+	uncompressed := publicKey.UncompressedBytes()
+	assert.Len(t, uncompressed, 65)
+	assert.Equal(t, byte(4), uncompressed[0])
+	assert.Len(t, publicKey.RawBytes(), 33)
+
+	fromUncompressed, err := keypair.NewPublicKeyFromRaw(uncompressed)
+	require.NoError(t, err)
+	assert.Equal(t, publicKey.ToHex(), fromUncompressed.ToHex())
+	assert.Equal(t, publicKey.AccountHash(), fromUncompressed.AccountHash())
+}
+
+// The expected files are produced by openssl from the private keys.
+func Test_KeyEncoding_MatchesOpenSSL(t *testing.T) {
+	secp, err := keypair.ParsePrivateKey(readKeyFile(t, "account_test_SECP_secret_key.pem"))
+	require.NoError(t, err)
+	assert.Equal(t, keypair.SECP256K1, secp.Algorithm())
+	publicPem, err := secp.PublicKey().ToPem()
+	require.NoError(t, err)
+	assert.Equal(t, string(readKeyFile(t, "account_test_SECP_public_key.pem")), string(publicPem))
+	privatePem, err := secp.ToPem()
+	require.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(readKeyFile(t, "account_test_SECP_secret_key.pem"))), strings.TrimSpace(string(privatePem)))
+
+	pkcs8, err := keypair.ParsePrivateKey(readKeyFile(t, "account_test_SECP_secret_key_pkcs8.pem"))
+	require.NoError(t, err)
+	assert.True(t, secp.PublicKey().Equals(pkcs8.PublicKey()))
+
+	ed, err := keypair.ParsePrivateKey(readKeyFile(t, "secret_key.pem"))
+	require.NoError(t, err)
+	assert.Equal(t, keypair.ED25519, ed.Algorithm())
+	privatePem, err = ed.ToPem()
+	require.NoError(t, err)
+	assert.Equal(t, string(readKeyFile(t, "secret_key.pem")), string(privatePem))
+	edPublic, err := keypair.ParsePublicKey(readKeyFile(t, "public_key.pem"))
+	require.NoError(t, err)
+	assert.True(t, ed.PublicKey().Equals(edPublic))
+	publicPem, err = ed.PublicKey().ToPem()
+	require.NoError(t, err)
+	assert.Equal(t, string(readKeyFile(t, "public_key.pem")), string(publicPem))
+}
+
+func Test_KeyFiles_CasperClientLayout(t *testing.T) {
+	for _, algorithm := range []keypair.KeyAlgorithm{keypair.ED25519, keypair.SECP256K1} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "keys")
+			privateKey, err := keypair.GeneratePrivateKey(algorithm)
+			require.NoError(t, err)
+			require.NoError(t, keypair.WriteKeyFiles(dir, privateKey))
+
+			info, err := os.Stat(filepath.Join(dir, keypair.SecretKeyFileName))
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+			loaded, err := keypair.NewPrivateKeyFromKeyDir(dir)
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, loaded.Algorithm())
+			assert.True(t, privateKey.PublicKey().Equals(loaded.PublicKey()))
+
+			publicKey, err := keypair.NewPublicKeyFromKeyDir(dir)
+			require.NoError(t, err)
+			assert.True(t, privateKey.PublicKey().Equals(publicKey))
+
+			require.NoError(t, os.Remove(filepath.Join(dir, keypair.PublicKeyHexFileName)))
+			publicKey, err = keypair.NewPublicKeyFromKeyDir(dir)
+			require.NoError(t, err)
+			assert.True(t, privateKey.PublicKey().Equals(publicKey))
+
+			other, err := keypair.GeneratePrivateKey(algorithm)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filepath.Join(dir, keypair.PublicKeyHexFileName), []byte(other.PublicKey().ToHex()), 0644))
+			_, err = keypair.NewPrivateKeyFromKeyDir(dir)
+			assert.True(t, errors.Is(err, keypair.ErrKeyFilesMismatch))
+		})
+	}
+}
+
+func Test_KeyEncoding_RejectsUnknownFormat(t *testing.T) {
+	_, err := keypair.ParsePublicKey([]byte("not a key"))
+	assert.True(t, errors.Is(err, keypair.ErrUnknownKeyFormat))
+	_, err = keypair.ParsePrivateKey([]byte("-----BEGIN CERTIFICATE-----\nMAA=\n-----END CERTIFICATE-----\n"))
+	assert.True(t, errors.Is(err, keypair.ErrUnknownKeyFormat))
+}
+
+func readKeyFile(t *testing.T, name string) []byte {
+	content, err := os.ReadFile(filepath.Join("../../data/keys", name))
+	require.NoError(t, err)
+	return content
+}
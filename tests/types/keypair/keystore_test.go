@@ -0,0 +1,163 @@
+package keypair
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+func Test_Keystore_CreateUnlock(t *testing.T) {
+	for _, algorithm := range []keypair.KeyAlgorithm{keypair.ED25519, keypair.SECP256K1} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			privateKey, err := keypair.GeneratePrivateKey(algorithm)
+			require.NoError(t, err)
+
+			keystore, err := keypair.NewKeystoreWithParams(privateKey, []byte("correct horse"), keypair.LightScryptParams)
+			require.NoError(t, err)
+			assert.Equal(t, algorithm.String(), keystore.Algorithm)
+			assert.Equal(t, privateKey.PublicKey(), keystore.PublicKey)
+
+			path := filepath.Join(t.TempDir(), keystore.FileName())
+			require.NoError(t, keystore.WriteFile(path))
+			loaded, err := keypair.NewKeystoreFromFile(path)
+			require.NoError(t, err)
+
+			unlocked, err := loaded.Unlock([]byte("correct horse"))
+			require.NoError(t, err)
+			assert.Equal(t, privateKey.PublicKey(), unlocked.PublicKey())
+			signature, err := unlocked.Sign([]byte("message"))
+			require.NoError(t, err)
+			assert.NoError(t, privateKey.PublicKey().VerifySignature([]byte("message"), signature))
+
+			_, err = loaded.Unlock([]byte("wrong horse"))
+			assert.True(t, errors.Is(err, keypair.ErrInvalidKeystorePassword))
+		})
+	}
+}
+
+func Test_Keystore_ChangePassword(t *testing.T) {
+	privateKey, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+	keystore, err := keypair.NewKeystoreWithParams(privateKey, []byte("old"), keypair.LightScryptParams)
+	require.NoError(t, err)
+
+	assert.True(t, errors.Is(keystore.ChangePassword([]byte("wrong"), []byte("new")), keypair.ErrInvalidKeystorePassword))
+	require.NoError(t, keystore.ChangePassword([]byte("old"), []byte("new")))
+
+	_, err = keystore.Unlock([]byte("old"))
+	assert.True(t, errors.Is(err, keypair.ErrInvalidKeystorePassword))
+	unlocked, err := keystore.Unlock([]byte("new"))
+	require.NoError(t, err)
+	assert.Equal(t, privateKey.PublicKey(), unlocked.PublicKey())
+	assert.Equal(t, keypair.LightScryptParams, keystore.Crypto.KDF.Params)
+}
+
+func Test_Keystore_RejectsTamperedFile(t *testing.T) {
+	privateKey, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+	otherKey, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+	keystore, err := keypair.NewKeystoreWithParams(privateKey, []byte("password"), keypair.LightScryptParams)
+	require.NoError(t, err)
+
+	swapped := *keystore
+	swapped.PublicKey = otherKey.PublicKey()
+	_, err = swapped.Unlock([]byte("password"))
+	assert.True(t, errors.Is(err, keypair.ErrInvalidKeystorePassword))
+
+	unsupported := *keystore
+	unsupported.Version = 2
+	_, err = unsupported.Unlock([]byte("password"))
+	assert.True(t, errors.Is(err, keypair.ErrUnsupportedKeystore))
+
+	_, err = keypair.NewKeystoreWithParams(privateKey, nil, keypair.LightScryptParams)
+	assert.True(t, errors.Is(err, keypair.ErrEmptyKeystorePassword))
+}
+
+func Test_Keystore_RejectsUnboundedScryptParams(t *testing.T) {
+	privateKey, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+	keystore, err := keypair.NewKeystoreWithParams(privateKey, []byte("password"), keypair.LightScryptParams)
+	require.NoError(t, err)
+
+	for name, params := range map[string]keypair.ScryptParams{
+		"N too big":        {N: 1 << 30, R: 8, P: 1},
+		"N not power of 2": {N: 3000, R: 8, P: 1},
+		"N zero":           {N: 0, R: 8, P: 1},
+		"r*p too big":      {N: 1 << 12, R: 8, P: 1 << 20},
+		"r zero":           {N: 1 << 12, R: 0, P: 1},
+		"memory too big":   {N: 1 << 20, R: 16, P: 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.True(t, errors.Is(params.Validate(), keypair.ErrInvalidScryptParams))
+
+			_, err := keypair.NewKeystoreWithParams(privateKey, []byte("password"), params)
+			assert.True(t, errors.Is(err, keypair.ErrInvalidScryptParams))
+
+			crafted := *keystore
+			crafted.Crypto.KDF.Params = params
+			path := filepath.Join(t.TempDir(), crafted.FileName())
+			require.NoError(t, crafted.WriteFile(path))
+			_, err = keypair.NewKeystoreFromFile(path)
+			assert.True(t, errors.Is(err, keypair.ErrUnsupportedKeystore))
+			assert.True(t, errors.Is(err, keypair.ErrInvalidScryptParams))
+			_, err = crafted.Unlock([]byte("password"))
+			assert.True(t, errors.Is(err, keypair.ErrInvalidScryptParams))
+		})
+	}
+	assert.NoError(t, keypair.DefaultScryptParams.Validate())
+	assert.NoError(t, keypair.LightScryptParams.Validate())
+}
+
+func Test_PrivateKey_Zero(t *testing.T) {
+	for _, algorithm := range []keypair.KeyAlgorithm{keypair.ED25519, keypair.SECP256K1} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			privateKey, err := keypair.GeneratePrivateKey(algorithm)
+			require.NoError(t, err)
+			keystore, err := keypair.NewKeystoreWithParams(privateKey, []byte("password"), keypair.LightScryptParams)
+			require.NoError(t, err)
+
+			privateKey.Zero()
+			assert.Equal(t, keystore.PublicKey, privateKey.PublicKey())
+			signature, err := privateKey.Sign([]byte("message"))
+			if err == nil {
+				assert.Error(t, privateKey.PublicKey().VerifySignature([]byte("message"), signature))
+			}
+
+			unlocked, err := keystore.Unlock([]byte("password"))
+			require.NoError(t, err)
+			signature, err = unlocked.Sign([]byte("message"))
+			require.NoError(t, err)
+			assert.NoError(t, keystore.PublicKey.VerifySignature([]byte("message"), signature))
+		})
+	}
+}
+
+func Test_ListKeystores(t *testing.T) {
+	dir := t.TempDir()
+	publicKeys := make(map[string]bool)
+	for _, algorithm := range []keypair.KeyAlgorithm{keypair.ED25519, keypair.SECP256K1} {
+		privateKey, err := keypair.GeneratePrivateKey(algorithm)
+		require.NoError(t, err)
+		keystore, err := keypair.NewKeystoreWithParams(privateKey, []byte("password"), keypair.LightScryptParams)
+		require.NoError(t, err)
+		require.NoError(t, keystore.WriteFile(filepath.Join(dir, keystore.FileName())))
+		publicKeys[privateKey.PublicKey().ToHex()] = true
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.json"), []byte(`{"version": 7}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("keys"), 0600))
+
+	files, err := keypair.ListKeystores(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	for _, file := range files {
+		assert.True(t, publicKeys[file.Keystore.PublicKey.ToHex()])
+		assert.Equal(t, filepath.Join(dir, file.Keystore.FileName()), file.Path)
+	}
+}
@@ -0,0 +1,120 @@
+package keypair
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+func Test_SignMessage_VerifyWithAndWithoutTag(t *testing.T) {
+	for _, algorithm := range []keypair.KeyAlgorithm{keypair.ED25519, keypair.SECP256K1} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			privateKey, err := keypair.GeneratePrivateKey(algorithm)
+			require.NoError(t, err)
+			publicKey := privateKey.PublicKey()
+
+			signature, err := privateKey.SignMessage("Sign in to example.com")
+			require.NoError(t, err)
+			assert.Len(t, signature, 65)
+			assert.Equal(t, algorithm.Byte(), signature[0])
+			assert.NoError(t, publicKey.VerifyMessage("Sign in to example.com", signature))
+			assert.NoError(t, publicKey.VerifyMessage("Sign in to example.com", signature[1:]))
+			assert.NoError(t, publicKey.VerifySignature([]byte("Casper Message:\nSign in to example.com"), signature))
+
+			// the wallets return the signature without the algorithm tag
+			walletSignature, err := privateKey.RawSign(keypair.FormatMessage("Sign in to example.com"))
+			require.NoError(t, err)
+			assert.NoError(t, publicKey.VerifyMessage("Sign in to example.com", walletSignature))
+
+			assert.True(t, errors.Is(publicKey.VerifyMessage("Sign in to another.com", signature), keypair.ErrInvalidSignature))
+			unprefixed, err := privateKey.Sign([]byte("Sign in to example.com"))
+			require.NoError(t, err)
+			assert.True(t, errors.Is(publicKey.VerifyMessage("Sign in to example.com", unprefixed), keypair.ErrInvalidSignature))
+			wrongTag := append([]byte{3}, signature[1:]...)
+			assert.True(t, errors.Is(publicKey.VerifyMessage("Sign in to example.com", wrongTag), keypair.ErrInvalidSignature))
+			assert.True(t, errors.Is(publicKey.VerifyMessage("Sign in to example.com", nil), keypair.ErrEmptySignature))
+		})
+	}
+}
+
+// Signatures of the message "Sign in to example.com" by the keys derived at the Casper paths from the BIP-39 reference mnemonic.
+// Both schemes are deterministic, ED25519 by RFC 8032 and SECP256K1 with the RFC 6979 nonce and the low S as in casper-js-sdk,
+// so the vectors are computed by an independent implementation checked against the reference vectors of these RFCs.
+func Test_SignMessage_KnownVectors(t *testing.T) {
+	tests := []struct {
+		algorithm  keypair.KeyAlgorithm
+		privateKey string
+		publicKey  string
+		signature  string
+	}{
+		{
+			algorithm:  keypair.ED25519,
+			privateKey: "619386127005778f66a68fa91518c0841f59495790bb796fc781ecdd54fe329a",
+			publicKey:  "016a1585d8197fc14b1d8cc05d5351e5ba04810d466158a050494c799b776ff819",
+			signature:  "d4179c0d417146628e3beea91c020e918a9c1a45ecbe544c07da3899260aaf41eb27d29445e76d962a016882affea19d85bbea36a4037700916b9708d737f505",
+		},
+		{
+			algorithm:  keypair.SECP256K1,
+			privateKey: "9c72144893c3ca5fa7299e65a7d7d6c41ab6a7add5f9860618324854d3c369d1",
+			publicKey:  "020357f9e27d8125932c5e6fd52babb1a114bc89363f2f56c7860bb594f74523342b",
+			signature:  "16a7633d49a7d79d228ed35e4ad79ede5d27fa62d658c866a0bf55c2f969d1716e2ad24569a66d3d90c1f5281a28a5673b40c223e014a74fcc10db602bc60259",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.algorithm.String(), func(t *testing.T) {
+			raw, err := hex.DecodeString(test.privateKey)
+			require.NoError(t, err)
+			privateKey, err := keypair.NewPrivateKeyFromRaw(raw, test.algorithm)
+			require.NoError(t, err)
+			require.Equal(t, test.publicKey, privateKey.PublicKey().ToHex())
+
+			signature, err := privateKey.SignMessage("Sign in to example.com")
+			require.NoError(t, err)
+			assert.Equal(t, test.signature, hex.EncodeToString(signature[1:]))
+
+			walletSignature, err := hex.DecodeString(test.signature)
+			require.NoError(t, err)
+			publicKey, err := keypair.NewPublicKey(test.publicKey)
+			require.NoError(t, err)
+			assert.NoError(t, publicKey.VerifyMessage("Sign in to example.com", walletSignature))
+			assert.Error(t, publicKey.VerifyMessage("Sign in to example.org", walletSignature))
+		})
+	}
+}
+
+func Test_ChallengeStore_Authenticate(t *testing.T) {
+	privateKey, err := keypair.NewPrivateKeyFromFile("../../data/keys/docker-nctl-rc3-secret.pem", keypair.ED25519)
+	require.NoError(t, err)
+	other, err := keypair.GeneratePrivateKey(keypair.SECP256K1)
+	require.NoError(t, err)
+
+	store := keypair.NewChallengeStore("example.com", time.Minute)
+	challenge, err := store.Issue(privateKey.PublicKey())
+	require.NoError(t, err)
+	assert.Contains(t, challenge.Message(), "example.com wants you to sign in with your Casper account:\n"+privateKey.PublicKey().ToHex())
+	assert.Contains(t, challenge.Message(), "Nonce: "+challenge.Nonce)
+
+	signature, err := privateKey.SignMessage(challenge.Message())
+	require.NoError(t, err)
+	assert.NoError(t, store.Authenticate(privateKey.PublicKey(), challenge.Nonce, signature[1:]))
+	assert.True(t, errors.Is(store.Authenticate(privateKey.PublicKey(), challenge.Nonce, signature), keypair.ErrUnknownChallenge))
+
+	challenge, err = store.Issue(privateKey.PublicKey())
+	require.NoError(t, err)
+	otherSignature, err := other.SignMessage(challenge.Message())
+	require.NoError(t, err)
+	assert.True(t, errors.Is(store.Authenticate(other.PublicKey(), challenge.Nonce, otherSignature), keypair.ErrChallengeKeyMismatch))
+
+	challenge, err = store.Issue(privateKey.PublicKey())
+	require.NoError(t, err)
+	signature, err = privateKey.SignMessage(challenge.Message())
+	require.NoError(t, err)
+	assert.NoError(t, challenge.Verify(signature, challenge.IssuedAt))
+	assert.True(t, errors.Is(challenge.Verify(signature, challenge.ExpiresAt), keypair.ErrChallengeExpired))
+}
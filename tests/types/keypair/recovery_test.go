@@ -0,0 +1,109 @@
+package keypair
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+func Test_RecoverPublicKey_FromRecoverableSignature(t *testing.T) {
+	privateKey, err := keypair.ParsePrivateKey(readKeyFile(t, "account_test_SECP_secret_key.pem"))
+	require.NoError(t, err)
+	message := []byte("bridge transfer #42")
+
+	for i := 0; i < 8; i++ {
+		message = append(message, byte(i))
+		signature, err := privateKey.SignRecoverable(message)
+		require.NoError(t, err)
+		require.Len(t, signature, 65)
+
+		tagged, err := privateKey.Sign(message)
+		require.NoError(t, err)
+		assert.Equal(t, tagged[1:], signature[:64])
+
+		recovered, err := keypair.RecoverPublicKey(message, signature)
+		require.NoError(t, err)
+		assert.True(t, privateKey.PublicKey().Equals(recovered))
+		assert.Equal(t, privateKey.PublicKey().AccountHash(), recovered.AccountHash())
+
+		// V with the offset of 27
+		signature[64] += 27
+		recovered, err = keypair.RecoverPublicKey(message, signature)
+		require.NoError(t, err)
+		assert.True(t, privateKey.PublicKey().Equals(recovered))
+	}
+}
+
+func Test_RecoverPublicKey_MatchesDecredCompactSignature(t *testing.T) {
+	privateKey, err := keypair.ParsePrivateKey(readKeyFile(t, "account_test_SECP_secret_key.pem"))
+	require.NoError(t, err)
+	message := []byte("message")
+
+	hash := sha256.Sum256(message)
+	compact := ecdsa.SignCompact(secp256k1.PrivKeyFromBytes(privateKey.RawBytes()), hash[:], true)
+	signature := append(append([]byte{}, compact[1:]...), compact[0]-27-4)
+
+	recovered, err := keypair.RecoverPublicKey(message, signature)
+	require.NoError(t, err)
+	assert.True(t, privateKey.PublicKey().Equals(recovered))
+}
+
+func Test_RecoverPublicKey_Rejects(t *testing.T) {
+	edKey, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+	_, err = edKey.SignRecoverable([]byte("message"))
+	assert.True(t, errors.Is(err, keypair.ErrRecoveryNotSupported))
+
+	secpKey, err := keypair.GeneratePrivateKey(keypair.SECP256K1)
+	require.NoError(t, err)
+	signature, err := secpKey.SignRecoverable([]byte("message"))
+	require.NoError(t, err)
+	signature[64] = 9
+	_, err = keypair.RecoverPublicKey([]byte("message"), signature)
+	assert.Error(t, err)
+	_, err = keypair.RecoverPublicKey([]byte("message"), signature[:64])
+	assert.Error(t, err)
+}
+
+func Test_MatchSignature_AssociatedKeys(t *testing.T) {
+	first, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+	second, err := keypair.GeneratePrivateKey(keypair.SECP256K1)
+	require.NoError(t, err)
+	stranger, err := keypair.GeneratePrivateKey(keypair.SECP256K1)
+	require.NoError(t, err)
+	candidates := keypair.PublicKeyList{first.PublicKey(), second.PublicKey()}
+	message := []byte("message")
+
+	tagged, err := second.Sign(message)
+	require.NoError(t, err)
+	recoverable, err := second.SignRecoverable(message)
+	require.NoError(t, err)
+	edTagged, err := first.Sign(message)
+	require.NoError(t, err)
+
+	for name, signature := range map[string][]byte{
+		"tagged":      tagged,
+		"raw":         tagged[1:],
+		"recoverable": recoverable,
+	} {
+		matched, err := keypair.MatchSignature(message, signature, candidates)
+		require.NoError(t, err, name)
+		assert.True(t, second.PublicKey().Equals(matched), name)
+	}
+	matched, err := keypair.MatchSignature(message, edTagged[1:], candidates)
+	require.NoError(t, err)
+	assert.True(t, first.PublicKey().Equals(matched))
+
+	strangerSignature, err := stranger.SignRecoverable(message)
+	require.NoError(t, err)
+	_, err = keypair.MatchSignature(message, strangerSignature, candidates)
+	assert.True(t, errors.Is(err, keypair.ErrNoMatchingPublicKey))
+}
@@ -0,0 +1,97 @@
+package keypair
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+func Test_RemoteSigner_SignsWithAlgorithmTag(t *testing.T) {
+	for _, algorithm := range []keypair.KeyAlgorithm{keypair.ED25519, keypair.SECP256K1} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			privateKey, err := keypair.GeneratePrivateKey(algorithm)
+			require.NoError(t, err)
+			server := httptest.NewServer(keypair.NewRemoteSignerHandler(privateKey))
+			defer server.Close()
+
+			var signer keypair.Signer = keypair.NewRemoteSigner(server.URL, privateKey.PublicKey(), nil)
+			assert.Equal(t, algorithm, signer.Algorithm())
+			assert.Equal(t, privateKey.PublicKey(), signer.PublicKey())
+
+			digest := []byte("digest")
+			signature, err := signer.SignContext(context.Background(), digest)
+			require.NoError(t, err)
+			assert.Equal(t, algorithm.Byte(), signature[0])
+			assert.NoError(t, privateKey.PublicKey().VerifySignature(digest, signature))
+		})
+	}
+}
+
+func Test_RemoteSigner_SignsTransactions(t *testing.T) {
+	privateKey, err := casper.NewED25519PrivateKeyFromPEMFile("../../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	server := httptest.NewServer(keypair.NewRemoteSignerHandler(privateKey))
+	defer server.Close()
+	signer := casper.NewRemoteSigner(server.URL, privateKey.PublicKey(), http.DefaultClient)
+
+	transaction, err := types.NewTransferTransactionBuilder().
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(signer.PublicKey()).
+		WithTargetPublicKey(signer.PublicKey()).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+	require.NoError(t, transaction.SignWith(context.Background(), signer))
+	require.Len(t, transaction.Approvals, 1)
+	assert.NoError(t, transaction.Validate())
+
+	deploy, err := types.NewTransferDeployBuilder().
+		WithChainName("casper-net-1").
+		WithAccount(signer.PublicKey()).
+		WithPaymentAmount(big.NewInt(100000000)).
+		WithTargetPublicKey(signer.PublicKey()).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+	require.NoError(t, deploy.SignWith(context.Background(), signer))
+	require.NoError(t, deploy.Sign(privateKey))
+	assert.Equal(t, deploy.Approvals[0], deploy.Approvals[1])
+	assert.NoError(t, deploy.Validate())
+}
+
+func Test_RemoteSigner_RejectsUnknownKeyAndForgedSignature(t *testing.T) {
+	privateKey, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+	otherKey, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(keypair.NewRemoteSignerHandler(privateKey))
+	defer server.Close()
+	_, err = keypair.NewRemoteSigner(server.URL, otherKey.PublicKey(), nil).SignContext(context.Background(), []byte("digest"))
+	assert.True(t, errors.Is(err, keypair.ErrRemoteSignerUnknownKey))
+
+	forging := httptest.NewServer(keypair.NewRemoteSignerHandler(forgingSigner{PrivateKey: otherKey, publicKey: privateKey.PublicKey()}))
+	defer forging.Close()
+	_, err = keypair.NewRemoteSigner(forging.URL, privateKey.PublicKey(), nil).SignContext(context.Background(), []byte("digest"))
+	assert.True(t, errors.Is(err, keypair.ErrRemoteSignerBadSignature))
+}
+
+// forgingSigner claims the public key of another key pair.
+type forgingSigner struct {
+	keypair.PrivateKey
+	publicKey keypair.PublicKey
+}
+
+func (s forgingSigner) PublicKey() keypair.PublicKey {
+	return s.publicKey
+}
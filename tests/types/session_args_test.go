@@ -0,0 +1,186 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+const (
+	sessionArgAccountHash = "account-hash-bf06bdb1616050cea5862333d1f4787718f1011c95574ba92378419eefeeee59"
+	sessionArgURef        = "uref-7b12008bb757ee32caefb3f7a1f77d9f659ee7a4e21ad4950c4e0294000492eb-007"
+	sessionArgPublicKey   = "01c377281132044bd3278b039925eeb3efdb9d99dd5f46d9ec6a764add34581af7"
+)
+
+func Test_ParseSessionArg_SimpleTypes(t *testing.T) {
+	tests := []struct {
+		arg      string
+		expected clvalue.CLValue
+	}{
+		{arg: "a:bool='true'", expected: clvalue.NewCLBool(true)},
+		{arg: "a:i32='-12'", expected: clvalue.NewCLInt32(-12)},
+		{arg: "a:i64='-9000000000'", expected: *clvalue.NewCLInt64(-9000000000)},
+		{arg: "a:u8='255'", expected: *clvalue.NewCLUint8(255)},
+		{arg: "a:u32='7'", expected: *clvalue.NewCLUInt32(7)},
+		{arg: "a:u64='18446744073709551615'", expected: *clvalue.NewCLUInt64(18446744073709551615)},
+		{arg: "a:u128='1000'", expected: *clvalue.NewCLUInt128(big.NewInt(1000))},
+		{arg: "a:u256='1000'", expected: *clvalue.NewCLUInt256(big.NewInt(1000))},
+		{arg: "amount:u512='1000000'", expected: *clvalue.NewCLUInt512(big.NewInt(1000000))},
+		{arg: "a:unit=''", expected: *clvalue.NewCLUnit()},
+		{arg: "a:string='hello: world='", expected: *clvalue.NewCLString("hello: world=")},
+		{arg: "target:key='" + sessionArgAccountHash + "'", expected: clvalue.NewCLKey(mustKey(t, sessionArgAccountHash))},
+		{arg: "a:account_hash='" + sessionArgAccountHash + "'", expected: clvalue.NewCLByteArray(mustKey(t, sessionArgAccountHash).Account.Bytes())},
+		{arg: "a:uref='" + sessionArgURef + "'", expected: clvalue.NewCLUref(mustURef(t, sessionArgURef))},
+		{arg: "a:byte_array_2='0aff'", expected: clvalue.NewCLByteArray([]byte{0x0a, 0xff})},
+		{arg: "opt:opt_u64='5'", expected: clvalue.NewCLOption(*clvalue.NewCLUInt64(5))},
+		{arg: "opt:opt_string=null", expected: clvalue.CLValue{Type: cltype.NewOptionType(cltype.String), Option: &clvalue.Option{Type: cltype.NewOptionType(cltype.String)}}},
+	}
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			_, value, err := types.ParseSessionArg(test.arg)
+			require.NoError(t, err)
+			assertSameCLValue(t, test.expected, value)
+		})
+	}
+
+	name, value, err := types.ParseSessionArg("validator:public_key='" + sessionArgPublicKey + "'")
+	require.NoError(t, err)
+	assert.Equal(t, "validator", name)
+	assert.Equal(t, sessionArgPublicKey, value.PublicKey.ToHex())
+}
+
+func Test_ParseSessionArg_Errors(t *testing.T) {
+	tests := []struct {
+		arg     string
+		target  error
+		message string
+	}{
+		{arg: "amount", target: types.ErrInvalidSessionArg, message: "':' is missing"},
+		{arg: "amount:u512", target: types.ErrInvalidSessionArg, message: "'=' is missing"},
+		{arg: "amount:u512='1", target: types.ErrInvalidSessionArg, message: "single quotes"},
+		{arg: "amount:float='1'", target: clvalue.ErrUnknownSimpleType, message: "type: float"},
+		{arg: "amount:u512='-1'", target: clvalue.ErrInvalidValue, message: "negative or out of range"},
+		{arg: "a:u8='256'", target: clvalue.ErrInvalidValue, message: "out of range"},
+		{arg: "a:bool='yes'", target: clvalue.ErrInvalidValue, message: "true or false"},
+		{arg: "a:byte_array_4='0aff'", target: clvalue.ErrInvalidValue, message: "4 bytes are expected, got 2"},
+		{arg: "a:key='hash'", target: clvalue.ErrInvalidValue, message: "formatted key"},
+		{arg: "a:account_hash='bf06bdb1616050cea5862333d1f4787718f1011c95574ba92378419eefeeee59'", target: clvalue.ErrInvalidValue, message: "account-hash-<hex>"},
+	}
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			_, _, err := types.ParseSessionArg(test.arg)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, test.target), err.Error())
+			assert.Contains(t, err.Error(), test.message)
+		})
+	}
+
+	_, err := types.NewArgsFromSessionArgs([]string{"a:u8='1'", "a:u8='2'"})
+	assert.ErrorContains(t, err, "duplicated name")
+}
+
+func Test_NewArgsFromSessionArgsJSON(t *testing.T) {
+	source := `[
+		{"name": "amount", "type": "U512", "value": "1000000"},
+		{"name": "small", "type": "U512", "value": 25},
+		{"name": "flag", "type": "Bool", "value": true},
+		{"name": "id", "type": {"Option": "U64"}, "value": null},
+		{"name": "target", "type": "Key", "value": {"Account": "` + sessionArgAccountHash + `"}},
+		{"name": "purse", "type": "URef", "value": "` + sessionArgURef + `"},
+		{"name": "validator", "type": "PublicKey", "value": "` + sessionArgPublicKey + `"},
+		{"name": "names", "type": {"List": "String"}, "value": ["a", "b"]},
+		{"name": "hash", "type": {"ByteArray": 2}, "value": "0aff"},
+		{"name": "bytes", "type": {"ByteArray": 2}, "value": [10, 255]},
+		{"name": "result", "type": {"Result": {"ok": "Bool", "err": "U8"}}, "value": {"Err": 3}},
+		{"name": "weights", "type": {"Map": {"key": "String", "value": "I32"}}, "value": [{"key": "ABC", "value": 10}]},
+		{"name": "pair", "type": {"Tuple2": ["U8", {"List": "U32"}]}, "value": [1, [2, 3]]},
+		{"name": "unit", "type": "Unit", "value": null}
+	]`
+	args, err := types.NewArgsFromSessionArgsJSON([]byte(source))
+	require.NoError(t, err)
+	require.Len(t, *args, 14)
+
+	expectedBytes := map[string]string{
+		"amount":  "0340420f08",
+		"small":   "011908",
+		"flag":    "0100",
+		"id":      "000d05",
+		"hash":    "0aff0f02000000",
+		"bytes":   "0aff0f02000000",
+		"result":  "0003100003",
+		"weights": "01000000030000004142430a000000110a01",
+		"pair":    "0102000000020000000300000013030e04",
+		"unit":    "09",
+	}
+	for name, expected := range expectedBytes {
+		arg, err := args.Find(name)
+		require.NoError(t, err)
+		value, err := arg.Value()
+		require.NoError(t, err)
+		data, err := clvalue.ToBytesWithType(value)
+		require.NoError(t, err)
+		// the length prefix of the value is skipped
+		assert.Equal(t, expected, hex.EncodeToString(data[4:]), name)
+	}
+
+	var names []string
+	require.NoError(t, args.Unmarshal("names", &names))
+	assert.Equal(t, []string{"a", "b"}, names)
+	var target key.Key
+	require.NoError(t, args.Unmarshal("target", &target))
+	assert.Equal(t, sessionArgAccountHash, target.String())
+}
+
+func Test_NewArgsFromSessionArgsJSON_Errors(t *testing.T) {
+	tests := []struct {
+		source  string
+		message string
+	}{
+		{source: `{"name": "a"}`, message: "array of"},
+		{source: `[{"name": "a", "type": "Float", "value": 1}]`, message: "arg: a, details: unknown type"},
+		{source: `[{"name": "a", "type": "U8"}]`, message: "value is missing"},
+		{source: `[{"name": "a", "type": "U8", "value": "1"}]`, message: "number is expected"},
+		{source: `[{"name": "a", "type": {"List": "U8"}, "value": [1, 300]}]`, message: "path: value[1]"},
+		{source: `[{"name": "a", "type": {"Map": {"key": "U8", "value": "U8"}}, "value": [{"key": 1}]}]`, message: "path: value[0]"},
+		{source: `[{"name": "a", "type": {"Map": {"key": "U8", "value": "U8"}}, "value": [{"key": 1, "value": 1}, {"key": 1, "value": 2}]}]`, message: "path: value[1].key"},
+		{source: `[{"name": "a", "type": {"Result": {"ok": "Bool", "err": "U8"}}, "value": {"Some": 1}}]`, message: "unknown Result variant"},
+		{source: `[{"name": "a", "type": {"Tuple2": ["U8", "U8"]}, "value": [1]}]`, message: "array of 2 elements"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			_, err := types.NewArgsFromSessionArgsJSON([]byte(test.source))
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, types.ErrInvalidSessionArg))
+			assert.Contains(t, err.Error(), test.message)
+		})
+	}
+}
+
+func assertSameCLValue(t *testing.T, expected, actual clvalue.CLValue) {
+	expectedBytes, err := clvalue.ToBytesWithType(expected)
+	require.NoError(t, err)
+	actualBytes, err := clvalue.ToBytesWithType(actual)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(expectedBytes), hex.EncodeToString(actualBytes))
+}
+
+func mustKey(t *testing.T, source string) key.Key {
+	result, err := key.NewKey(source)
+	require.NoError(t, err)
+	return result
+}
+
+func mustURef(t *testing.T, source string) key.URef {
+	result, err := key.NewURef(source)
+	require.NoError(t, err)
+	return result
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/types"
+)
+
+func Test_SigningEnvelope_ExportImport(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+
+	transactionV1, err := types.NewTransferTransactionBuilder().
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(pubKey).
+		WithTargetPublicKey(pubKey).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+	deploy, err := types.NewTransferDeployBuilder().
+		WithChainName("casper-net-1").
+		WithAccount(pubKey).
+		WithPaymentAmount(big.NewInt(100000000)).
+		WithTargetPublicKey(pubKey).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+
+	transactions := map[string]types.Transaction{
+		"transaction v1": types.NewTransactionFromTransactionV1(*transactionV1),
+		"deploy":         types.NewTransactionFromDeploy(*deploy),
+	}
+	for name, transaction := range transactions {
+		t.Run(name, func(t *testing.T) {
+			envelope, err := types.NewSigningEnvelope(transaction, map[string]string{"purpose": "cold storage transfer", "amount": "2.5 CSPR"})
+			require.NoError(t, err)
+			assert.Empty(t, envelope.Approvals())
+
+			encoded, err := envelope.Bytes()
+			require.NoError(t, err)
+			fromBytes, err := types.NewSigningEnvelopeFromBytes(encoded)
+			require.NoError(t, err)
+			reEncoded, err := fromBytes.Bytes()
+			require.NoError(t, err)
+			assert.Equal(t, encoded, reEncoded)
+			assert.Equal(t, envelope.Metadata, fromBytes.Metadata)
+
+			data, err := json.Marshal(envelope)
+			require.NoError(t, err)
+			fromJSON, err := types.NewSigningEnvelopeFromJSON(data)
+			require.NoError(t, err)
+			assert.Equal(t, transaction.Hash, fromJSON.Hash)
+			assert.Equal(t, "casper-net-1", fromJSON.ChainName)
+
+			require.NoError(t, fromJSON.Sign(keys))
+			signed, err := fromJSON.Transaction()
+			require.NoError(t, err)
+			assert.Len(t, signed.Approvals, 1)
+			assert.Empty(t, transaction.Approvals)
+		})
+	}
+}
+
+func Test_SigningEnvelope_MergeApprovals(t *testing.T) {
+	firstKeys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	secondKeys, err := casper.NewSECP256k1PrivateKeyFromPEMFile("../data/keys/account_test_SECP_secret_key.pem")
+	require.NoError(t, err)
+
+	transactionV1, err := types.NewTransferTransactionBuilder().
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(firstKeys.PublicKey()).
+		WithTargetPublicKey(secondKeys.PublicKey()).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+	unsigned, err := types.NewSigningEnvelope(types.NewTransactionFromTransactionV1(*transactionV1), nil)
+	require.NoError(t, err)
+
+	exported, err := unsigned.Bytes()
+	require.NoError(t, err)
+	first, err := types.NewSigningEnvelopeFromBytes(exported)
+	require.NoError(t, err)
+	require.NoError(t, first.Sign(firstKeys))
+	second, err := types.NewSigningEnvelopeFromBytes(exported)
+	require.NoError(t, err)
+	require.NoError(t, second.Sign(secondKeys))
+	require.NoError(t, second.Sign(secondKeys))
+	assert.Len(t, second.Approvals(), 1)
+
+	merged, err := types.MergeSigningEnvelopes(first, second, first)
+	require.NoError(t, err)
+	assert.Len(t, merged.Approvals(), 2)
+	assert.NoError(t, merged.Verify())
+
+	require.NoError(t, unsigned.AttachApprovals(second))
+	assert.Len(t, unsigned.Approvals(), 1)
+	assert.True(t, unsigned.Approvals()[0].Signer.Equals(secondKeys.PublicKey()))
+}
+
+func Test_SigningEnvelope_RejectsMismatchedBody(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	build := func(amount int64) *types.SigningEnvelope {
+		transactionV1, err := types.NewTransferTransactionBuilder().
+			WithChainName("casper-net-1").
+			WithInitiatorPublicKey(keys.PublicKey()).
+			WithTargetPublicKey(keys.PublicKey()).
+			WithAmount(big.NewInt(amount)).
+			Build()
+		require.NoError(t, err)
+		envelope, err := types.NewSigningEnvelope(types.NewTransactionFromTransactionV1(*transactionV1), nil)
+		require.NoError(t, err)
+		return envelope
+	}
+	envelope, other := build(2500000000), build(5000000000)
+
+	err = envelope.AttachApprovals(other)
+	assert.True(t, errors.Is(err, types.ErrEnvelopeTransactionsDiffer))
+
+	tampered := *envelope
+	tampered.Hash = other.Hash
+	encoded, err := tampered.Bytes()
+	require.NoError(t, err)
+	_, err = types.NewSigningEnvelopeFromBytes(encoded)
+	assert.True(t, errors.Is(err, types.ErrEnvelopeHashMismatch))
+
+	tampered = *envelope
+	body := *envelope.Body.TransactionV1
+	body.Payload.ChainName = "casper"
+	tampered.Body.TransactionV1 = &body
+	err = tampered.Verify()
+	assert.True(t, errors.Is(err, types.ErrEnvelopeInvalidBody))
+	assert.True(t, errors.Is(err, types.ErrInvalidTransactionHash))
+	assert.False(t, errors.Is(err, types.ErrEnvelopeHashMismatch))
+
+	tampered = *envelope
+	tampered.ChainName = "casper"
+	data, err := json.Marshal(tampered)
+	require.NoError(t, err)
+	_, err = types.NewSigningEnvelopeFromJSON(data)
+	assert.True(t, errors.Is(err, types.ErrEnvelopeChainNameMismatch))
+
+	encoded, err = envelope.Bytes()
+	require.NoError(t, err)
+	encoded[0] = 2
+	_, err = types.NewSigningEnvelopeFromBytes(encoded)
+	assert.True(t, errors.Is(err, types.ErrUnsupportedEnvelopeVersion))
+}
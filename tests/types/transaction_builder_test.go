@@ -0,0 +1,301 @@
+package types
+
+import (
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+func Test_TransferTransactionBuilder_SameAsManualPayload(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+	target, err := casper.NewPublicKey("0106ed45915392c02b37136618372ac8dde8e0e3b8ee6190b2ca6db539b354ede4")
+	require.NoError(t, err)
+	timestamp := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	pricingMode := types.PricingMode{
+		Limited: &types.LimitedMode{
+			GasPriceTolerance: 1,
+			StandardPayment:   true,
+			PaymentAmount:     100000000,
+		},
+	}
+
+	transaction, err := types.NewTransferTransactionBuilder().
+		WithTargetPublicKey(target).
+		WithAmount(big.NewInt(2500000000)).
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(pubKey).
+		WithTimestamp(timestamp).
+		WithTTL(30 * time.Minute).
+		WithPricingMode(pricingMode).
+		Build()
+	require.NoError(t, err)
+
+	args := &types.Args{}
+	args.AddArgument("target", clvalue.NewCLPublicKey(target)).
+		AddArgument("amount", *clvalue.NewCLUInt512(big.NewInt(2500000000)))
+	payload, err := types.NewTransactionV1Payload(
+		types.InitiatorAddr{PublicKey: &pubKey},
+		types.Timestamp(timestamp),
+		types.Duration(30*time.Minute),
+		"casper-net-1",
+		pricingMode,
+		types.NewNamedArgs(args),
+		types.TransactionTarget{Native: &struct{}{}},
+		types.TransactionEntryPoint{Transfer: &struct{}{}},
+		types.TransactionScheduling{Standard: &struct{}{}},
+	)
+	require.NoError(t, err)
+	expected, err := types.MakeTransactionV1(payload)
+	require.NoError(t, err)
+
+	assert.Equal(t, expected.Hash, transaction.Hash)
+	require.NoError(t, transaction.Sign(keys))
+	assert.NoError(t, transaction.Validate())
+}
+
+func Test_NativeTransactionBuilders_Build(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+	validator, err := casper.NewPublicKey("0106ed45915392c02b37136618372ac8dde8e0e3b8ee6190b2ca6db539b354ede4")
+	require.NoError(t, err)
+	newValidator, err := casper.NewPublicKey("01d2c5b9e0b5e3b4b4a6e2b2d3f4c2c8f5b3a1d0e9f8a7b6c5d4e3f2a1b0c9d8e7")
+	require.NoError(t, err)
+	amount := big.NewInt(500000000000)
+
+	reservation := types.Reservation{DelegationRate: 5, ValidatorPublicKey: pubKey, DelegatorKind: types.DelegatorKind{PublicKey: &validator}}
+	reservationBytes, err := reservation.Bytes()
+	require.NoError(t, err)
+	delegatorBytes, err := types.DelegatorKind{PublicKey: &validator}.Bytes()
+	require.NoError(t, err)
+
+	type arg struct {
+		name   string
+		clType string
+	}
+	tests := map[string]struct {
+		build    func() (*types.TransactionV1, error)
+		args     []arg
+		elements [][]byte
+	}{
+		"add_bid": {
+			build: types.NewAddBidTransactionBuilder().WithPublicKey(pubKey).WithDelegationRate(10).WithAmount(amount).
+				WithDelegationAmountLimits(500000000000, 1000000000000000).WithReservedSlots(2).
+				WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build,
+			args: []arg{{"public_key", "PublicKey"}, {"delegation_rate", "U8"}, {"amount", "U512"},
+				{"minimum_delegation_amount", "U64"}, {"maximum_delegation_amount", "U64"}, {"reserved_slots", "U32"}},
+		},
+		"withdraw_bid": {
+			build: types.NewWithdrawBidTransactionBuilder().WithPublicKey(pubKey).WithAmount(amount).
+				WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build,
+			args: []arg{{"public_key", "PublicKey"}, {"amount", "U512"}},
+		},
+		"delegate": {
+			build: types.NewDelegateTransactionBuilder().WithDelegator(pubKey).WithValidator(validator).WithAmount(amount).
+				WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build,
+			args: []arg{{"delegator", "PublicKey"}, {"validator", "PublicKey"}, {"amount", "U512"}},
+		},
+		"undelegate": {
+			build: types.NewUndelegateTransactionBuilder().WithDelegator(pubKey).WithValidator(validator).WithAmount(amount).
+				WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build,
+			args: []arg{{"delegator", "PublicKey"}, {"validator", "PublicKey"}, {"amount", "U512"}},
+		},
+		"redelegate": {
+			build: types.NewRedelegateTransactionBuilder().WithDelegator(pubKey).WithValidator(validator).WithNewValidator(newValidator).WithAmount(amount).
+				WithChainName("casper-net-1").WithInitiatorAccountHash(pubKey.AccountHash()).Build,
+			args: []arg{{"delegator", "PublicKey"}, {"validator", "PublicKey"}, {"amount", "U512"}, {"new_validator", "PublicKey"}},
+		},
+		"activate_bid": {
+			build: types.NewActivateBidTransactionBuilder().WithValidator(pubKey).
+				WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build,
+			args: []arg{{"validator", "PublicKey"}},
+		},
+		"change_bid_public_key": {
+			build: types.NewChangeBidPublicKeyTransactionBuilder().WithPublicKey(pubKey).WithNewPublicKey(validator).
+				WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build,
+			args: []arg{{"public_key", "PublicKey"}, {"new_public_key", "PublicKey"}},
+		},
+		"add_reservations": {
+			build: types.NewAddReservationsTransactionBuilder().WithReservations(reservation).
+				WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build,
+			args:     []arg{{"reservations", "(List of Any)"}},
+			elements: [][]byte{reservationBytes},
+		},
+		"cancel_reservations": {
+			build: types.NewCancelReservationsTransactionBuilder().WithValidator(pubKey).
+				WithDelegators(types.DelegatorKind{PublicKey: &validator}).
+				WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build,
+			args:     []arg{{"validator", "PublicKey"}, {"delegators", "(List of Any)"}},
+			elements: [][]byte{delegatorBytes},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			transaction, err := test.build()
+			require.NoError(t, err)
+			require.NotNil(t, transaction.Payload.Fields.Target.Native)
+
+			args := *transaction.Payload.Fields.NamedArgs.Args
+			require.Len(t, args, len(test.args))
+			var list *clvalue.List
+			for i, expected := range test.args {
+				name, err := args[i].Name()
+				require.NoError(t, err)
+				value, err := args[i].Value()
+				require.NoError(t, err)
+				assert.Equal(t, expected.name, name)
+				assert.Equal(t, expected.clType, value.Type.String(), expected.name)
+				if value.List != nil {
+					list = value.List
+				}
+			}
+			if test.elements != nil {
+				require.NotNil(t, list)
+				require.Len(t, list.Elements, len(test.elements))
+				for i, element := range test.elements {
+					assert.Equal(t, element, list.Elements[i].Any.Bytes())
+				}
+			}
+
+			require.NoError(t, transaction.Sign(keys))
+			assert.NoError(t, transaction.Validate())
+		})
+	}
+}
+
+func Test_NativeTransactionBuilders_Validation(t *testing.T) {
+	pubKey, err := casper.NewPublicKey("0106ed45915392c02b37136618372ac8dde8e0e3b8ee6190b2ca6db539b354ede4")
+	require.NoError(t, err)
+
+	_, err = types.NewTransferTransactionBuilder().WithAmount(big.NewInt(1)).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrMissingTransactionArgument)
+
+	_, err = types.NewTransferTransactionBuilder().WithTargetPublicKey(pubKey).WithAmount(big.NewInt(0)).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrInvalidTransactionArgument)
+
+	_, err = types.NewTransferTransactionBuilder().WithTargetPublicKey(pubKey).WithAmount(big.NewInt(1)).
+		WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrMissingChainName)
+
+	_, err = types.NewTransferTransactionBuilder().WithTargetPublicKey(pubKey).WithAmount(big.NewInt(1)).
+		WithChainName("casper-net-1").Build()
+	assert.ErrorIs(t, err, types.ErrMissingInitiator)
+
+	_, err = types.NewAddBidTransactionBuilder().WithPublicKey(pubKey).WithDelegationRate(101).WithAmount(big.NewInt(1)).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrInvalidTransactionArgument)
+
+	_, err = types.NewRedelegateTransactionBuilder().WithDelegator(pubKey).WithValidator(pubKey).WithAmount(big.NewInt(1)).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrMissingTransactionArgument)
+
+	_, err = types.NewCancelReservationsTransactionBuilder().WithValidator(pubKey).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrMissingTransactionArgument)
+}
+
+func Test_ContractCallTransactionBuilder_InvocationTargets(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+	hash, err := key.NewHash("a5542d422cc7102165bde32f8c8aa460a81dc64105b03efbcd9c612a7721dadb")
+	require.NoError(t, err)
+	version := uint32(2)
+
+	args := &types.Args{}
+	args.AddArgument("amount", *clvalue.NewCLUInt256(big.NewInt(100)))
+
+	builders := map[string]*types.ContractCallTransactionBuilder{
+		"by_hash":         types.NewContractCallTransactionBuilder().WithContractHash(hash),
+		"by_name":         types.NewContractCallTransactionBuilder().WithContractName("cep18"),
+		"by_package_hash": types.NewContractCallTransactionBuilder().WithPackageHash(hash, &version),
+		"by_package_name": types.NewContractCallTransactionBuilder().WithPackageName("cep18_package", nil),
+	}
+	for name, builder := range builders {
+		t.Run(name, func(t *testing.T) {
+			transaction, err := builder.WithEntryPoint("transfer").WithArgs(args).
+				WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+			require.NoError(t, err)
+			stored := transaction.Payload.Fields.Target.Stored
+			require.NotNil(t, stored)
+			assert.True(t, stored.Runtime.IsVmCasperV1())
+			assert.Equal(t, "transfer", *transaction.Payload.Fields.TransactionEntryPoint.Custom)
+			require.NoError(t, transaction.Sign(keys))
+			assert.NoError(t, transaction.Validate())
+		})
+	}
+}
+
+func Test_ContractCallTransactionBuilder_VmCasperV2(t *testing.T) {
+	pubKey, err := casper.NewPublicKey("0106ed45915392c02b37136618372ac8dde8e0e3b8ee6190b2ca6db539b354ede4")
+	require.NoError(t, err)
+	hash, err := key.NewHash("a5542d422cc7102165bde32f8c8aa460a81dc64105b03efbcd9c612a7721dadb")
+	require.NoError(t, err)
+
+	transaction, err := types.NewContractCallTransactionBuilder().WithContractHash(hash).WithEntryPoint("deposit").
+		WithVmCasperV2().WithTransferredValue(1000).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	require.NoError(t, err)
+	runtime := transaction.Payload.Fields.Target.Stored.Runtime
+	require.True(t, runtime.IsVmCasperV2())
+	assert.Equal(t, uint64(1000), runtime.VmCasperV2.TransferredValue)
+
+	_, err = types.NewContractCallTransactionBuilder().WithContractHash(hash).WithEntryPoint("deposit").
+		WithTransferredValue(1000).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrInvalidTransactionRuntime)
+
+	_, err = types.NewContractCallTransactionBuilder().WithContractHash(hash).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrMissingTransactionArgument)
+}
+
+func Test_SessionTransactionBuilder_Build(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+	moduleBytes, err := os.ReadFile("../data/wasm/cep18-rc3.wasm")
+	require.NoError(t, err)
+	seed, err := key.NewHash("a5542d422cc7102165bde32f8c8aa460a81dc64105b03efbcd9c612a7721dadb")
+	require.NoError(t, err)
+
+	transaction, err := types.NewSessionTransactionBuilder().WithModuleBytes(moduleBytes).WithInstallUpgrade(true).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	require.NoError(t, err)
+	session := transaction.Payload.Fields.Target.Session
+	require.NotNil(t, session)
+	assert.True(t, session.IsInstallUpgrade)
+	assert.True(t, session.Runtime.IsVmCasperV1())
+	assert.NotNil(t, transaction.Payload.Fields.TransactionEntryPoint.Call)
+	require.NoError(t, transaction.Sign(keys))
+	assert.NoError(t, transaction.Validate())
+
+	transaction, err = types.NewSessionTransactionBuilder().WithModuleBytes(moduleBytes).WithInstallUpgrade(true).
+		WithVmCasperV2().WithSeed(seed).WithTransferredValue(5).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	require.NoError(t, err)
+	runtime := transaction.Payload.Fields.Target.Session.Runtime
+	require.True(t, runtime.IsVmCasperV2())
+	assert.Equal(t, seed, *runtime.VmCasperV2.Seed)
+
+	_, err = types.NewSessionTransactionBuilder().WithModuleBytes(moduleBytes).WithSeed(seed).
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrInvalidTransactionRuntime)
+
+	_, err = types.NewSessionTransactionBuilder().
+		WithChainName("casper-net-1").WithInitiatorPublicKey(pubKey).Build()
+	assert.ErrorIs(t, err, types.ErrMissingTransactionArgument)
+}
@@ -0,0 +1,202 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/casper"
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+func Test_TransactionV1_FromBytes_RoundTripFixtures(t *testing.T) {
+	fixtures := []struct {
+		path string
+		// the payload of some fixtures can't be reproduced from JSON, the hash is verified for the others only
+		verifyHash bool
+	}{
+		{"../data/transaction/get_transaction.json", false},
+		{"../data/transaction/get_transaction_install_contract.json", true},
+		{"../data/transaction/get_transaction_native_entry_point.json", false},
+		{"../data/transaction/get_transaction_native_target.json", true},
+	}
+	for _, fixture := range fixtures {
+		t.Run(fixture.path, func(t *testing.T) {
+			data, err := os.ReadFile(fixture.path)
+			require.NoError(t, err)
+			var response struct {
+				Result struct {
+					Transaction types.TransactionWrapper `json:"transaction"`
+				} `json:"result"`
+			}
+			require.NoError(t, json.Unmarshal(data, &response))
+			transaction := response.Result.Transaction.TransactionV1
+			require.NotNil(t, transaction)
+
+			encoded, err := transaction.Bytes()
+			require.NoError(t, err)
+			decoded, err := types.NewTransactionV1FromBytes(encoded)
+			require.NoError(t, err)
+
+			reEncoded, err := decoded.Bytes()
+			require.NoError(t, err)
+			assert.Equal(t, encoded, reEncoded)
+			assert.Equal(t, transaction.Hash, decoded.Hash)
+			assert.Len(t, decoded.Approvals, len(transaction.Approvals))
+			if fixture.verifyHash {
+				assert.NoError(t, decoded.Validate())
+			}
+		})
+	}
+}
+
+func Test_TransactionV1_FromBytes_BuiltTransactions(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+	contractHash, err := key.NewHash("8ff7a1c49017400013dcf78305343fa07c31b04292b7928845ed59764e1ee512")
+	require.NoError(t, err)
+	version := uint32(2)
+	args := &types.Args{}
+	args.AddArgument("recipient", *clvalue.NewCLString("alice")).
+		AddArgument("amount", *clvalue.NewCLUInt512(big.NewInt(1000)))
+
+	builders := map[string]func() (*types.TransactionV1, error){
+		"native transfer": func() (*types.TransactionV1, error) {
+			return types.NewTransferTransactionBuilder().
+				WithChainName("casper-net-1").
+				WithInitiatorPublicKey(pubKey).
+				WithTargetPublicKey(pubKey).
+				WithAmount(big.NewInt(2500000000)).
+				WithID(42).
+				WithScheduling(types.TransactionScheduling{FutureEra: &types.FutureEraScheduling{EraID: 12}}).
+				Build()
+		},
+		"stored by hash": func() (*types.TransactionV1, error) {
+			return types.NewContractCallTransactionBuilder().
+				WithChainName("casper-net-1").
+				WithInitiatorAccountHash(pubKey.AccountHash()).
+				WithContractHash(contractHash).
+				WithEntryPoint("transfer").
+				WithArgs(args).
+				WithPricingMode(types.PricingMode{Limited: &types.LimitedMode{PaymentAmount: 100000000, GasPriceTolerance: 1, StandardPayment: true}}).
+				Build()
+		},
+		"stored by package name on VmCasperV2": func() (*types.TransactionV1, error) {
+			return types.NewContractCallTransactionBuilder().
+				WithChainName("casper-net-1").
+				WithInitiatorPublicKey(pubKey).
+				WithPackageName("cep18", &version).
+				WithEntryPoint("transfer").
+				WithArgs(args).
+				WithVmCasperV2().
+				WithTransferredValue(7).
+				WithSeed(contractHash).
+				WithScheduling(types.TransactionScheduling{FutureTimestamp: &types.FutureTimestampScheduling{TimeStamp: types.Timestamp(time.UnixMilli(1732727256905).UTC())}}).
+				Build()
+		},
+		"stored by package hash": func() (*types.TransactionV1, error) {
+			return types.NewContractCallTransactionBuilder().
+				WithChainName("casper-net-1").
+				WithInitiatorPublicKey(pubKey).
+				WithPackageHash(contractHash, nil).
+				WithEntryPoint("transfer").
+				Build()
+		},
+		"session": func() (*types.TransactionV1, error) {
+			return types.NewSessionTransactionBuilder().
+				WithChainName("casper-net-1").
+				WithInitiatorPublicKey(pubKey).
+				WithModuleBytes([]byte{0x00, 0x61, 0x73, 0x6d}).
+				WithInstallUpgrade(true).
+				WithArgs(args).
+				Build()
+		},
+	}
+	for name, build := range builders {
+		t.Run(name, func(t *testing.T) {
+			transaction, err := build()
+			require.NoError(t, err)
+			require.NoError(t, transaction.Sign(keys))
+
+			encoded, err := transaction.Bytes()
+			require.NoError(t, err)
+			decoded, err := types.NewTransactionV1FromBytes(encoded)
+			require.NoError(t, err)
+
+			reEncoded, err := decoded.Bytes()
+			require.NoError(t, err)
+			assert.Equal(t, encoded, reEncoded)
+			assert.Equal(t, transaction.Hash, decoded.Hash)
+			assert.Equal(t, transaction.Payload.Fields.Target, decoded.Payload.Fields.Target)
+			assert.Equal(t, transaction.Payload.Fields.TransactionScheduling, decoded.Payload.Fields.TransactionScheduling)
+			assert.NoError(t, decoded.Validate())
+		})
+	}
+}
+
+func Test_Transaction_FromBytes_DetectsVersion(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+
+	transactionV1, err := types.NewTransferTransactionBuilder().
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(pubKey).
+		WithTargetPublicKey(pubKey).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+	deploy, err := types.NewTransferDeployBuilder().
+		WithChainName("casper-net-1").
+		WithAccount(pubKey).
+		WithPaymentAmount(big.NewInt(100000000)).
+		WithTargetPublicKey(pubKey).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+
+	encoded, err := (&types.TransactionWrapper{TransactionV1: transactionV1}).Bytes()
+	require.NoError(t, err)
+	transaction, err := types.NewTransactionFromBytes(encoded)
+	require.NoError(t, err)
+	require.NotNil(t, transaction.GetTransactionV1())
+	assert.Equal(t, transactionV1.Hash, transaction.Hash)
+
+	encoded, err = (&types.TransactionWrapper{Deploy: deploy}).Bytes()
+	require.NoError(t, err)
+	transaction, err = types.NewTransactionFromBytes(encoded)
+	require.NoError(t, err)
+	require.NotNil(t, transaction.GetDeploy())
+	assert.Equal(t, deploy.Hash, transaction.Hash)
+}
+
+func Test_TransactionV1_FromBytes_RejectsMalformedInput(t *testing.T) {
+	keys, err := casper.NewED25519PrivateKeyFromPEMFile("../data/keys/docker-nctl-rc3-secret.pem")
+	require.NoError(t, err)
+	pubKey := keys.PublicKey()
+
+	transaction, err := types.NewTransferTransactionBuilder().
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(pubKey).
+		WithTargetPublicKey(pubKey).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+	encoded, err := transaction.Bytes()
+	require.NoError(t, err)
+
+	_, err = types.NewTransactionV1FromBytes(append(encoded, 0))
+	assert.True(t, errors.Is(err, types.ErrTrailingBytes))
+
+	_, err = types.NewTransactionV1FromBytes(encoded[:len(encoded)-1])
+	assert.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/make-software/casper-go-sdk/v2/types"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+func Test_TransactionV1_Validate_ManyApprovals(t *testing.T) {
+	initiator, err := keypair.GeneratePrivateKey(keypair.ED25519)
+	require.NoError(t, err)
+	transaction, err := types.NewTransferTransactionBuilder().
+		WithChainName("casper-net-1").
+		WithInitiatorPublicKey(initiator.PublicKey()).
+		WithTargetPublicKey(initiator.PublicKey()).
+		WithAmount(big.NewInt(2500000000)).
+		Build()
+	require.NoError(t, err)
+
+	for i := 0; i < 24; i++ {
+		algorithm := keypair.ED25519
+		if i%2 == 0 {
+			algorithm = keypair.SECP256K1
+		}
+		signer, err := keypair.GeneratePrivateKey(algorithm)
+		require.NoError(t, err)
+		require.NoError(t, transaction.Sign(signer))
+	}
+	require.NoError(t, transaction.Validate())
+
+	transaction.Approvals[5].Signature = transaction.Approvals[7].Signature
+	err = transaction.Validate()
+	assert.True(t, errors.Is(err, types.ErrInvalidApprovalSignature))
+	assert.Contains(t, err.Error(), "approvals: [5]")
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+// ApprovalThresholds is the result of checking the approvals of a transaction against
+// the associated keys and action thresholds of the initiator account or entity.
+type ApprovalThresholds struct {
+	// Sum of the weights of the associated keys that provided a valid approval.
+	Weight uint64 `json:"weight"`
+	// Threshold that has to be met for a deployment action.
+	DeploymentThreshold uint64 `json:"deployment_threshold"`
+	// Threshold that has to be met for a key management action.
+	KeyManagementThreshold uint64 `json:"key_management_threshold"`
+	// True if the collected weight is enough to execute the transaction.
+	DeploymentMet bool `json:"deployment_met"`
+	// True if the collected weight is enough to manage the associated keys and thresholds.
+	KeyManagementMet bool `json:"key_management_met"`
+	// Associated keys that haven't approved the transaction yet.
+	MissingKeys []AssociatedKey `json:"missing_keys"`
+	// Approvals with an invalid signature or made by a key that isn't associated with the initiator.
+	RejectedApprovals []Approval `json:"rejected_approvals"`
+}
+
+// CheckApprovals sums the weights of the associated keys that signed the transaction hash and compares it with the ActionThresholds.
+func (a Account) CheckApprovals(hash key.Hash, approvals []Approval) ApprovalThresholds {
+	return checkApprovals(hash, approvals, a.AssociatedKeys, a.ActionThresholds.Deployment, a.ActionThresholds.KeyManagement)
+}
+
+// CheckApprovals sums the weights of the associated keys that signed the transaction hash and compares it with the EntityActionThresholds.
+func (e AddressableEntity) CheckApprovals(hash key.Hash, approvals []Approval) ApprovalThresholds {
+	return checkApprovals(hash, approvals, e.AssociatedKeys, e.ActionThresholds.Deployment, e.ActionThresholds.KeyManagement)
+}
+
+func checkApprovals(hash key.Hash, approvals []Approval, associatedKeys []AssociatedKey, deployment, keyManagement uint64) ApprovalThresholds {
+	result := ApprovalThresholds{
+		DeploymentThreshold:    deployment,
+		KeyManagementThreshold: keyManagement,
+		MissingKeys:            make([]AssociatedKey, 0),
+		RejectedApprovals:      make([]Approval, 0),
+	}
+
+	weights := make(map[key.Hash]uint64, len(associatedKeys))
+	for _, associatedKey := range associatedKeys {
+		weights[associatedKey.AccountHash.Hash] = associatedKey.Weight
+	}
+
+	approved := make(map[key.Hash]bool, len(approvals))
+	for _, approval := range approvals {
+		accountHash := approval.Signer.AccountHash().Hash
+		weight, ok := weights[accountHash]
+		if !ok || approval.Signer.VerifySignature(hash.Bytes(), approval.Signature) != nil {
+			result.RejectedApprovals = append(result.RejectedApprovals, approval)
+			continue
+		}
+		// several approvals of the same key are counted once
+		if !approved[accountHash] {
+			approved[accountHash] = true
+			result.Weight += weight
+		}
+	}
+
+	for _, associatedKey := range associatedKeys {
+		if !approved[associatedKey.AccountHash.Hash] {
+			result.MissingKeys = append(result.MissingKeys, associatedKey)
+		}
+	}
+	result.DeploymentMet = result.Weight >= deployment
+	result.KeyManagementMet = result.Weight >= keyManagement
+	return result
+}
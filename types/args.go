@@ -1,12 +1,15 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
 	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization/encoding"
 )
 
 var ErrArgumentNotFound = errors.New("argument is not found")
@@ -28,6 +31,11 @@ func (args Args) Bytes() ([]byte, error) {
 		}
 		result = append(result, clvalue.NewCLString(argName).Bytes()...)
 
+		if binary := arg.Argument().binary; binary != nil {
+			result = append(result, binary...)
+			continue
+		}
+
 		val, err := arg.Value()
 		if err != nil {
 			valueBytes, err := arg.Argument().Bytes()
@@ -35,7 +43,7 @@ func (args Args) Bytes() ([]byte, error) {
 				return nil, err
 			}
 			result = append(result, valueBytes...)
-			return result, nil
+			continue
 		}
 
 		valueBytes, err := clvalue.ToBytesWithType(val)
@@ -69,6 +77,90 @@ func (args *Args) AddArgument(name string, value clvalue.CLValue) *Args {
 	return args
 }
 
+// NewArgsFromStruct builds the Args from the exported fields of the struct, named by their `cl` tags,
+// e.g. `cl:"amount,u512"`, see clvalue.Marshal for the mapping of the Go types.
+func NewArgsFromStruct(v any) (*Args, error) {
+	fields, err := clvalue.MarshalFields(v)
+	if err != nil {
+		return nil, err
+	}
+	args := &Args{}
+	for _, field := range fields {
+		args.AddArgument(field.Name, field.Value)
+	}
+	return args, nil
+}
+
+// Unmarshal stores the argument with the name in the value pointed to by target, see clvalue.Unmarshal.
+func (args Args) Unmarshal(name string, target any) error {
+	arg, err := args.Find(name)
+	if err != nil {
+		return err
+	}
+	value, err := arg.Value()
+	if err != nil {
+		return err
+	}
+	return clvalue.Unmarshal(value, target)
+}
+
+// ArgsFromBytesDecoder decodes the Args serialized as the list of named CLValues with their types.
+type ArgsFromBytesDecoder struct{}
+
+func (d *ArgsFromBytesDecoder) FromBytes(source []byte) (Args, []byte, error) {
+	count, remainder, err := encoding.NewU32FromBytesDecoder().FromBytes(source)
+	if err != nil {
+		return nil, nil, decodingError("length", err)
+	}
+
+	args := make(Args, 0, count)
+	for i := uint32(0); i < count; i++ {
+		var name string
+		name, remainder, err = (&encoding.StringFromBytesDecoder{}).FromBytes(remainder)
+		if err != nil {
+			return nil, nil, decodingError(fmt.Sprintf("[%d].name", i), err)
+		}
+
+		valueStart := remainder
+		var value clvalue.CLValue
+		value, remainder, err = clValueFromBytes(remainder)
+		if err != nil {
+			return nil, nil, decodingError(name, err)
+		}
+
+		binary := make([]byte, len(valueStart)-len(remainder))
+		copy(binary, valueStart)
+		pair := PairArgument{}
+		pair[0] = &Argument{name: &name}
+		pair[1] = &Argument{value: &value, binary: binary}
+		args = append(args, pair)
+	}
+	return args, remainder, nil
+}
+
+// clValueFromBytes decodes the CLValue serialized with its type, unlike clvalue.FromBytes it checks the declared length.
+func clValueFromBytes(source []byte) (clvalue.CLValue, []byte, error) {
+	length, remainder, err := encoding.NewU32FromBytesDecoder().FromBytes(source)
+	if err != nil {
+		return clvalue.CLValue{}, nil, err
+	}
+	if uint64(len(remainder)) < uint64(length) {
+		return clvalue.CLValue{}, nil, serialization.ErrEarlyEndOfStream
+	}
+	valueBytes := remainder[:length]
+
+	buffer := bytes.NewBuffer(remainder[length:])
+	clType, err := cltype.FromBuffer(buffer)
+	if err != nil {
+		return clvalue.CLValue{}, nil, err
+	}
+	value, err := clvalue.FromBytesByType(valueBytes, clType)
+	if err != nil {
+		return clvalue.CLValue{}, nil, err
+	}
+	return value, buffer.Bytes(), nil
+}
+
 type PairArgument [2]*Argument
 
 func (r PairArgument) Name() (string, error) {
@@ -87,6 +179,8 @@ type Argument struct {
 	rawData json.RawMessage
 	name    *string
 	value   *clvalue.CLValue
+	// binary keeps the serialized value with its type as it was decoded, so the Args are encoded back exactly
+	binary []byte
 }
 
 func (a *Argument) Value() (clvalue.CLValue, error) {
@@ -108,15 +202,22 @@ func (a *Argument) Raw() (RawArg, error) {
 	return rawArg, nil
 }
 
+// Parsed returns the human-readable JSON of the value, as it was returned by the node or rendered from the value built locally.
 func (a *Argument) Parsed() (json.RawMessage, error) {
 	rawArg, err := a.Raw()
 	if err != nil {
 		return nil, err
 	}
+	if rawArg.Parsed == nil && a.value != nil {
+		return a.value.ToParsedJSON()
+	}
 	return rawArg.Parsed, nil
 }
 
 func (a *Argument) Bytes() (HexBytes, error) {
+	if a.binary != nil {
+		return a.binary, nil
+	}
 	if a.value != nil {
 		return clvalue.ToBytesWithType(*a.value)
 	}
@@ -128,7 +229,8 @@ func (a *Argument) Bytes() (HexBytes, error) {
 }
 
 func (a *Argument) UnmarshalJSON(bytes []byte) error {
-	a.rawData = bytes
+	// the data is decoded lazily, so it is copied, the decoder may reuse the buffer, e.g. in the streaming mode
+	a.rawData = append(json.RawMessage{}, bytes...)
 	return nil
 }
 
@@ -189,6 +189,16 @@ type Reservation struct {
 	DelegatorKind DelegatorKind `json:"delegator_kind"`
 }
 
+// Bytes returns the binary representation of the Reservation as it is expected by the auction contract.
+func (r Reservation) Bytes() ([]byte, error) {
+	result, err := r.DelegatorKind.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, r.ValidatorPublicKey.Bytes()...)
+	return append(result, r.DelegationRate), nil
+}
+
 type Unbond struct {
 	// Validator's public key.
 	ValidatorPublicKey keypair.PublicKey `json:"validator_public_key"`
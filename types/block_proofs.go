@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+var (
+	ErrInvalidBlockProof = errors.New("invalid block finality signature")
+	ErrEmptyChainName    = errors.New("chain name is required to verify the finality signatures of BlockV2")
+)
+
+// VerifyProofs checks the finality signatures of the block, they are verified in parallel for the blocks with many signatures.
+// A BlockV1 is signed over its hash and era, a BlockV2 also over its height and the hash of the chain name,
+// which is ignored for a BlockV1.
+// The error reports the indexes of all the invalid Proofs.
+func (b Block) VerifyProofs(chainName string) error {
+	message, err := b.proofMessage(chainName)
+	if err != nil {
+		return err
+	}
+
+	items := make([]keypair.SignatureVerification, 0, len(b.Proofs))
+	for _, proof := range b.Proofs {
+		items = append(items, keypair.SignatureVerification{
+			PublicKey: proof.PublicKey,
+			Message:   message,
+			Signature: proof.Signature,
+		})
+	}
+	var batchErr *keypair.BatchVerificationError
+	if err = keypair.VerifyBatch(items); errors.As(err, &batchErr) {
+		return fmt.Errorf("%w, proofs: %v", ErrInvalidBlockProof, batchErr.Failed)
+	}
+	return err
+}
+
+// proofMessage returns the bytes signed by the validators, FinalitySignatureV1 or FinalitySignatureV2 depending on the block version.
+func (b Block) proofMessage(chainName string) ([]byte, error) {
+	message := append([]byte{}, b.Hash.Bytes()...)
+	if b.originBlockV1 != nil {
+		return binary.LittleEndian.AppendUint64(message, b.EraID), nil
+	}
+
+	if chainName == "" {
+		return nil, ErrEmptyChainName
+	}
+	chainNameHash := blake2b.Sum256([]byte(chainName))
+	message = binary.LittleEndian.AppendUint64(message, b.Height)
+	message = binary.LittleEndian.AppendUint64(message, b.EraID)
+	return append(message, chainNameHash[:]...), nil
+}
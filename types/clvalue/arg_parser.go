@@ -0,0 +1,456 @@
+package clvalue
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+const (
+	// SimpleTypeOptionPrefix marks the optional type in the casper-client simple argument syntax, e.g. opt_u64.
+	SimpleTypeOptionPrefix = "opt_"
+	// SimpleTypeByteArrayPrefix is the prefix of the fixed size byte array type, e.g. byte_array_32.
+	SimpleTypeByteArrayPrefix = "byte_array_"
+	// SimpleTypeAccountHash is the 32 bytes array given as the formatted account hash, account-hash-<hex>.
+	SimpleTypeAccountHash = "account_hash"
+	// SimpleValueNull is the value of the empty optional argument.
+	SimpleValueNull = "null"
+)
+
+var (
+	ErrUnknownSimpleType = errors.New("unknown simple argument type")
+	ErrInvalidValue      = errors.New("invalid value for the type")
+)
+
+var simpleTypeByCasperClientName = map[string]cltype.CLType{
+	"bool":                cltype.Bool,
+	"i32":                 cltype.Int32,
+	"i64":                 cltype.Int64,
+	"u8":                  cltype.UInt8,
+	"u32":                 cltype.UInt32,
+	"u64":                 cltype.UInt64,
+	"u128":                cltype.UInt128,
+	"u256":                cltype.UInt256,
+	"u512":                cltype.UInt512,
+	"unit":                cltype.Unit,
+	"string":              cltype.String,
+	"key":                 cltype.Key,
+	"uref":                cltype.Uref,
+	"public_key":          cltype.PublicKey,
+	SimpleTypeAccountHash: cltype.NewByteArray(key.ByteHashLen),
+}
+
+// NewCLTypeFromSimpleName returns the CLType of the type name of the casper-client simple argument syntax:
+// bool, i32, i64, u8, u32, u64, u128, u256, u512, unit, string, key, account_hash, uref, public_key, byte_array_<size>
+// and any of them with the opt_ prefix.
+func NewCLTypeFromSimpleName(typeName string) (cltype.CLType, error) {
+	if inner, ok := strings.CutPrefix(typeName, SimpleTypeOptionPrefix); ok {
+		innerType, err := NewCLTypeFromSimpleName(inner)
+		if err != nil {
+			return nil, err
+		}
+		return cltype.NewOptionType(innerType), nil
+	}
+	if size, ok := strings.CutPrefix(typeName, SimpleTypeByteArrayPrefix); ok {
+		length, err := strconv.ParseUint(size, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w, type: %s, details: invalid size", ErrUnknownSimpleType, typeName)
+		}
+		return cltype.NewByteArray(uint32(length)), nil
+	}
+	if clType, ok := simpleTypeByCasperClientName[typeName]; ok {
+		return clType, nil
+	}
+	return nil, fmt.Errorf("%w, type: %s", ErrUnknownSimpleType, typeName)
+}
+
+// NewCLValueFromSimple parses the value of the argument given in the casper-client simple syntax, e.g. u512 and '1000'.
+// The value of the optional type is either null or the value of the inner type.
+func NewCLValueFromSimple(typeName, value string) (CLValue, error) {
+	clType, err := NewCLTypeFromSimpleName(typeName)
+	if err != nil {
+		return CLValue{}, err
+	}
+	if optionType, ok := clType.(*cltype.Option); ok {
+		if value == SimpleValueNull {
+			return CLValue{Type: optionType, Option: &Option{Type: optionType}}, nil
+		}
+		inner, err := newCLValueFromSimpleType(strings.TrimPrefix(typeName, SimpleTypeOptionPrefix), optionType.Inner, value)
+		if err != nil {
+			return CLValue{}, err
+		}
+		return CLValue{Type: optionType, Option: &Option{Type: optionType, Inner: &inner}}, nil
+	}
+	return newCLValueFromSimpleType(typeName, clType, value)
+}
+
+func newCLValueFromSimpleType(typeName string, clType cltype.CLType, value string) (CLValue, error) {
+	if typeName == SimpleTypeAccountHash {
+		accountHash, err := key.NewAccountHash(value)
+		if err != nil || !strings.HasPrefix(value, key.PrefixNameAccount) {
+			return CLValue{}, fmt.Errorf("%w, type: %s, value: '%s', details: account-hash-<hex> is expected", ErrInvalidValue, typeName, value)
+		}
+		data := ByteArray(accountHash.Bytes())
+		return CLValue{Type: clType, ByteArray: &data}, nil
+	}
+	return NewCLValueFromString(clType, value)
+}
+
+// NewCLValueFromString parses the text representation of the value of the simple CLType or the ByteArray given in hex.
+func NewCLValueFromString(clType cltype.CLType, value string) (CLValue, error) {
+	invalid := func(details string) error {
+		return fmt.Errorf("%w, type: %s, value: '%s', details: %s", ErrInvalidValue, clType.String(), value, details)
+	}
+
+	switch t := clType.(type) {
+	case *cltype.ByteArray:
+		data, err := hex.DecodeString(value)
+		if err != nil {
+			return CLValue{}, invalid("hex string is expected")
+		}
+		if len(data) != t.Len() {
+			return CLValue{}, invalid(fmt.Sprintf("%d bytes are expected, got %d", t.Len(), len(data)))
+		}
+		byteArray := ByteArray(data)
+		return CLValue{Type: t, ByteArray: &byteArray}, nil
+	case cltype.SimpleType:
+	default:
+		return CLValue{}, invalid("only simple types and ByteArray have the text representation")
+	}
+
+	switch clType.GetTypeID() {
+	case cltype.TypeIDBool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil || (value != "true" && value != "false") {
+			return CLValue{}, invalid("true or false is expected")
+		}
+		return NewCLBool(parsed), nil
+	case cltype.TypeIDI32, cltype.TypeIDI64:
+		bitSize := 64
+		if clType.GetTypeID() == cltype.TypeIDI32 {
+			bitSize = 32
+		}
+		parsed, err := strconv.ParseInt(value, 10, bitSize)
+		if err != nil {
+			return CLValue{}, invalid(numberErrorDetails(err))
+		}
+		if bitSize == 32 {
+			return NewCLInt32(int32(parsed)), nil
+		}
+		return *NewCLInt64(parsed), nil
+	case cltype.TypeIDU8, cltype.TypeIDU32, cltype.TypeIDU64:
+		bitSize := map[cltype.TypeID]int{cltype.TypeIDU8: 8, cltype.TypeIDU32: 32, cltype.TypeIDU64: 64}[clType.GetTypeID()]
+		parsed, err := strconv.ParseUint(value, 10, bitSize)
+		if err != nil {
+			return CLValue{}, invalid(numberErrorDetails(err))
+		}
+		switch bitSize {
+		case 8:
+			return *NewCLUint8(uint8(parsed)), nil
+		case 32:
+			return *NewCLUInt32(uint32(parsed)), nil
+		default:
+			return *NewCLUInt64(parsed), nil
+		}
+	case cltype.TypeIDU128, cltype.TypeIDU256, cltype.TypeIDU512:
+		number, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return CLValue{}, invalid("decimal integer is expected")
+		}
+		result, err := newCLBigInt(number, clType)
+		if err != nil {
+			return CLValue{}, invalid("value is negative or out of range")
+		}
+		return result, nil
+	case cltype.TypeIDUnit:
+		if value != "" {
+			return CLValue{}, invalid("unit has no value")
+		}
+		return *NewCLUnit(), nil
+	case cltype.TypeIDString:
+		return *NewCLString(value), nil
+	case cltype.TypeIDKey:
+		parsed, err := key.NewKey(value)
+		if err != nil {
+			return CLValue{}, invalid("formatted key is expected, e.g. account-hash-<hex>, " + err.Error())
+		}
+		return NewCLKey(parsed), nil
+	case cltype.TypeIDURef:
+		parsed, err := key.NewURef(value)
+		if err != nil {
+			return CLValue{}, invalid("formatted URef is expected, uref-<hex>-<access rights>, " + err.Error())
+		}
+		return NewCLUref(parsed), nil
+	case cltype.TypeIDPublicKey:
+		parsed, err := parsePublicKeyHex(value)
+		if err != nil {
+			return CLValue{}, invalid("hex public key with the algorithm tag is expected")
+		}
+		return NewCLPublicKey(parsed), nil
+	}
+	return CLValue{}, invalid("type has no text representation")
+}
+
+// NewCLValueFromJSON builds the value of the type from its JSON representation used in the casper-client
+// --session-args-json arguments: numbers (the big numbers may be strings), the formatted Key and URef strings,
+// hex strings for PublicKey and ByteArray, null for Unit and the empty Option, arrays for List and Tuple,
+// {"Ok": value} or {"Err": value} for Result and the array of {"key": key, "value": value} objects for Map.
+func NewCLValueFromJSON(clType cltype.CLType, source json.RawMessage) (CLValue, error) {
+	decoder := json.NewDecoder(bytes.NewReader(source))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return CLValue{}, fmt.Errorf("%w, type: %s, details: %s", ErrInvalidValue, clType.String(), err.Error())
+	}
+	return newCLValueFromJSON(clType, data, "value")
+}
+
+func newCLValueFromJSON(clType cltype.CLType, data any, path string) (CLValue, error) {
+	invalid := func(details string) error {
+		return fmt.Errorf("%w, path: %s, type: %s, details: %s", ErrInvalidValue, path, clType.String(), details)
+	}
+
+	switch t := clType.(type) {
+	case *cltype.Option:
+		if data == nil {
+			return CLValue{Type: t, Option: &Option{Type: t}}, nil
+		}
+		inner, err := newCLValueFromJSON(t.Inner, data, path)
+		if err != nil {
+			return CLValue{}, err
+		}
+		return CLValue{Type: t, Option: &Option{Type: t, Inner: &inner}}, nil
+	case *cltype.List:
+		elements, ok := data.([]any)
+		if !ok {
+			return CLValue{}, invalid("array is expected")
+		}
+		list := List{Type: t, Elements: make([]CLValue, 0, len(elements))}
+		for i, element := range elements {
+			value, err := newCLValueFromJSON(t.ElementsType, element, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return CLValue{}, err
+			}
+			list.Append(value)
+		}
+		return CLValue{Type: t, List: &list}, nil
+	case *cltype.ByteArray:
+		if elements, ok := data.([]any); ok {
+			raw := make([]byte, 0, len(elements))
+			for _, element := range elements {
+				number, ok := element.(json.Number)
+				parsed, err := strconv.ParseUint(number.String(), 10, 8)
+				if !ok || err != nil {
+					return CLValue{}, invalid("array of bytes is expected")
+				}
+				raw = append(raw, byte(parsed))
+			}
+			data = hex.EncodeToString(raw)
+		}
+		text, ok := data.(string)
+		if !ok {
+			return CLValue{}, invalid("hex string is expected")
+		}
+		value, err := NewCLValueFromString(t, text)
+		if err != nil {
+			return CLValue{}, fmt.Errorf("%w, path: %s", err, path)
+		}
+		return value, nil
+	case *cltype.Result:
+		object, ok := data.(map[string]any)
+		if !ok || len(object) != 1 {
+			return CLValue{}, invalid(`{"Ok": value} or {"Err": value} is expected`)
+		}
+		for variant, inner := range object {
+			var innerType cltype.CLType
+			switch variant {
+			case "Ok":
+				innerType = t.InnerOk
+			case "Err":
+				innerType = t.InnerErr
+			default:
+				return CLValue{}, invalid(fmt.Sprintf("unknown Result variant %s", variant))
+			}
+			value, err := newCLValueFromJSON(innerType, inner, path+"."+variant)
+			if err != nil {
+				return CLValue{}, err
+			}
+			return CLValue{Type: t, Result: &Result{Type: t, IsSuccess: variant == "Ok", Inner: value}}, nil
+		}
+	case *cltype.Map:
+		return newMapFromJSON(t, data, path)
+	case *cltype.Tuple1, *cltype.Tuple2, *cltype.Tuple3:
+		return newTupleFromJSON(t, data, path)
+	}
+
+	switch clType.GetTypeID() {
+	case cltype.TypeIDBool:
+		value, ok := data.(bool)
+		if !ok {
+			return CLValue{}, invalid("true or false is expected")
+		}
+		return NewCLBool(value), nil
+	case cltype.TypeIDUnit:
+		if data != nil {
+			return CLValue{}, invalid("null is expected")
+		}
+		return *NewCLUnit(), nil
+	case cltype.TypeIDI32, cltype.TypeIDI64, cltype.TypeIDU8, cltype.TypeIDU32, cltype.TypeIDU64:
+		number, ok := data.(json.Number)
+		if !ok {
+			return CLValue{}, invalid("number is expected")
+		}
+		return newCLValueFromStringAt(clType, number.String(), path)
+	case cltype.TypeIDU128, cltype.TypeIDU256, cltype.TypeIDU512:
+		switch number := data.(type) {
+		case json.Number:
+			return newCLValueFromStringAt(clType, number.String(), path)
+		case string:
+			return newCLValueFromStringAt(clType, number, path)
+		}
+		return CLValue{}, invalid("number or decimal string is expected")
+	case cltype.TypeIDKey:
+		// casper-client also accepts the key wrapped into the object of its variant, e.g. {"Account": "account-hash-<hex>"}
+		if object, ok := data.(map[string]any); ok && len(object) == 1 {
+			for _, inner := range object {
+				data = inner
+			}
+		}
+		fallthrough
+	case cltype.TypeIDString, cltype.TypeIDURef, cltype.TypeIDPublicKey:
+		text, ok := data.(string)
+		if !ok {
+			return CLValue{}, invalid("string is expected")
+		}
+		return newCLValueFromStringAt(clType, text, path)
+	}
+	return CLValue{}, invalid("type is not supported")
+}
+
+func newMapFromJSON(mapType *cltype.Map, data any, path string) (CLValue, error) {
+	type entry struct {
+		key, value any
+	}
+	var entries []entry
+	switch source := data.(type) {
+	case []any:
+		for i, one := range source {
+			object, ok := one.(map[string]any)
+			_, hasKey := object["key"]
+			_, hasValue := object["value"]
+			if !ok || !hasKey || !hasValue || len(object) != 2 {
+				return CLValue{}, fmt.Errorf(`%w, path: %s[%d], type: %s, details: {"key": key, "value": value} is expected`,
+					ErrInvalidValue, path, i, mapType.String())
+			}
+			entries = append(entries, entry{key: object["key"], value: object["value"]})
+		}
+	case map[string]any:
+		// the object form is accepted for the String keys, ordered by the key as the JSON object has no order
+		if mapType.Key.GetTypeID() != cltype.TypeIDString {
+			return CLValue{}, fmt.Errorf("%w, path: %s, type: %s, details: object is allowed only for String keys",
+				ErrInvalidValue, path, mapType.String())
+		}
+		keys := make([]string, 0, len(source))
+		for one := range source {
+			keys = append(keys, one)
+		}
+		sort.Strings(keys)
+		for _, one := range keys {
+			entries = append(entries, entry{key: one, value: source[one]})
+		}
+	default:
+		return CLValue{}, fmt.Errorf(`%w, path: %s, type: %s, details: array of {"key": key, "value": value} is expected`,
+			ErrInvalidValue, path, mapType.String())
+	}
+
+	result := newMap(mapType)
+	for i, one := range entries {
+		keyValue, err := newCLValueFromJSON(mapType.Key, one.key, fmt.Sprintf("%s[%d].key", path, i))
+		if err != nil {
+			return CLValue{}, err
+		}
+		val, err := newCLValueFromJSON(mapType.Val, one.value, fmt.Sprintf("%s[%d].value", path, i))
+		if err != nil {
+			return CLValue{}, err
+		}
+		if err = result.Append(keyValue, val); err != nil {
+			return CLValue{}, fmt.Errorf("%w, path: %s[%d].key, details: %s", ErrInvalidValue, path, i, err.Error())
+		}
+	}
+	return CLValue{Type: mapType, Map: result}, nil
+}
+
+func newTupleFromJSON(clType cltype.CLType, data any, path string) (CLValue, error) {
+	var inner []cltype.CLType
+	switch t := clType.(type) {
+	case *cltype.Tuple1:
+		inner = []cltype.CLType{t.Inner}
+	case *cltype.Tuple2:
+		inner = []cltype.CLType{t.Inner1, t.Inner2}
+	case *cltype.Tuple3:
+		inner = []cltype.CLType{t.Inner1, t.Inner2, t.Inner3}
+	}
+	source, ok := data.([]any)
+	if !ok || len(source) != len(inner) {
+		return CLValue{}, fmt.Errorf("%w, path: %s, type: %s, details: array of %d elements is expected",
+			ErrInvalidValue, path, clType.String(), len(inner))
+	}
+
+	elements := make([]CLValue, len(inner))
+	for i, one := range source {
+		element, err := newCLValueFromJSON(inner[i], one, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return CLValue{}, err
+		}
+		elements[i] = element
+	}
+	switch t := clType.(type) {
+	case *cltype.Tuple1:
+		return CLValue{Type: t, Tuple1: &Tuple1{innerType: t, innerVal: elements[0]}}, nil
+	case *cltype.Tuple2:
+		return CLValue{Type: t, Tuple2: &Tuple2{innerType: t, Inner1: elements[0], Inner2: elements[1]}}, nil
+	default:
+		t3 := clType.(*cltype.Tuple3)
+		return CLValue{Type: t3, Tuple3: &Tuple3{innerType: t3, Inner1: elements[0], Inner2: elements[1], Inner3: elements[2]}}, nil
+	}
+}
+
+func newCLValueFromStringAt(clType cltype.CLType, value, path string) (CLValue, error) {
+	result, err := NewCLValueFromString(clType, value)
+	if err != nil {
+		return CLValue{}, fmt.Errorf("%w, path: %s", err, path)
+	}
+	return result, nil
+}
+
+func parsePublicKeyHex(value string) (keypair.PublicKey, error) {
+	data, err := hex.DecodeString(value)
+	if err != nil {
+		return keypair.PublicKey{}, err
+	}
+	publicKey, err := keypair.NewPublicKeyFromBytes(data)
+	if err != nil {
+		return keypair.PublicKey{}, err
+	}
+	if !bytes.Equal(publicKey.Bytes(), data) {
+		return keypair.PublicKey{}, keypair.ErrInvalidPublicKeySize
+	}
+	return publicKey, nil
+}
+
+func numberErrorDetails(err error) string {
+	if errors.Is(err, strconv.ErrRange) {
+		return "value is out of range"
+	}
+	return "decimal integer is expected"
+}
@@ -2,12 +2,15 @@ package clvalue
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
 	"github.com/make-software/casper-go-sdk/v2/types/key"
 	"github.com/make-software/casper-go-sdk/v2/types/keypair"
 )
 
+var ErrUnknownCLType = errors.New("type is not implemented in CLValue")
+
 type IValue interface {
 	Bytes() []byte
 	String() string
@@ -52,64 +55,76 @@ func (c CLValue) ToBytesWithType() ([]byte, error) {
 }
 
 func (c CLValue) String() string {
-	return c.GetValueByType().String()
+	value, err := c.GetValueByType()
+	if err != nil {
+		return ""
+	}
+	return value.String()
 }
 
 func (c CLValue) Bytes() []byte {
-	return c.GetValueByType().Bytes()
+	value, err := c.GetValueByType()
+	if err != nil {
+		return nil
+	}
+	return value.Bytes()
 }
 
-func (c CLValue) GetValueByType() IValue {
+// GetValueByType returns the field of the value of its type, ErrUnknownCLType for the value without a known type.
+func (c CLValue) GetValueByType() (IValue, error) {
+	if c.Type == nil {
+		return nil, fmt.Errorf("%w, details: type is empty", ErrUnknownCLType)
+	}
 	switch c.Type.GetTypeID() {
 	case cltype.TypeIDBool:
-		return c.Bool
+		return c.Bool, nil
 	case cltype.TypeIDI32:
-		return c.I32
+		return c.I32, nil
 	case cltype.TypeIDI64:
-		return c.I64
+		return c.I64, nil
 	case cltype.TypeIDU8:
-		return c.UI8
+		return c.UI8, nil
 	case cltype.TypeIDU32:
-		return c.UI32
+		return c.UI32, nil
 	case cltype.TypeIDU64:
-		return c.UI64
+		return c.UI64, nil
 	case cltype.TypeIDU128:
-		return c.UI128
+		return c.UI128, nil
 	case cltype.TypeIDU256:
-		return c.UI256
+		return c.UI256, nil
 	case cltype.TypeIDU512:
-		return c.UI512
+		return c.UI512, nil
 	case cltype.TypeIDUnit:
-		return c.Unit
+		return c.Unit, nil
 	case cltype.TypeIDString:
-		return c.StringVal
+		return c.StringVal, nil
 	case cltype.TypeIDKey:
-		return c.Key
+		return c.Key, nil
 	case cltype.TypeIDURef:
-		return c.Uref
+		return c.Uref, nil
 	case cltype.TypeIDOption:
-		return c.Option
+		return c.Option, nil
 	case cltype.TypeIDList:
-		return c.List
+		return c.List, nil
 	case cltype.TypeIDByteArray:
-		return c.ByteArray
+		return c.ByteArray, nil
 	case cltype.TypeIDResult:
-		return c.Result
+		return c.Result, nil
 	case cltype.TypeIDMap:
-		return c.Map
+		return c.Map, nil
 	case cltype.TypeIDTuple1:
-		return c.Tuple1
+		return c.Tuple1, nil
 	case cltype.TypeIDTuple2:
-		return c.Tuple2
+		return c.Tuple2, nil
 	case cltype.TypeIDTuple3:
-		return c.Tuple3
+		return c.Tuple3, nil
 	case cltype.TypeIDAny:
-		return c.Any
+		return c.Any, nil
 	case cltype.TypeIDPublicKey:
-		return c.PublicKey
+		return c.PublicKey, nil
 	}
 
-	panic("type in GetValueByType method of CLValue is not implemented")
+	return nil, fmt.Errorf("%w, type: %s", ErrUnknownCLType, c.Type.String())
 }
 
 func (c *CLValue) GetKey() (*key.Key, error) {
@@ -0,0 +1,199 @@
+package clvalue
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"sort"
+	"strings"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+// Equals reports whether the values have the same type and the same content, the entries of Map are compared regardless of their order.
+func (c CLValue) Equals(other CLValue) bool {
+	return c.Compare(other) == 0
+}
+
+// Compare returns -1, 0 or 1 ordering the values the same way as the Ord of the corresponding casper-types Rust types:
+// numbers by value, String and byte arrays lexicographically, None before Some, Ok before Err,
+// List and Tuple element by element and Map by its entries sorted by the keys.
+// The values of the different types are ordered by the bytes of their types,
+// the values without the payload of their type, e.g. CLValue{Type: cltype.Bool}, go before the other values of the type.
+func (c CLValue) Compare(other CLValue) int {
+	if c.Type == nil || other.Type == nil {
+		return cmp.Compare(boolToInt(c.Type != nil), boolToInt(other.Type != nil))
+	}
+	if result := bytes.Compare(c.GetType().Bytes(), other.GetType().Bytes()); result != 0 {
+		return result
+	}
+	if first, second := c.hasPayload(), other.hasPayload(); !first || !second {
+		return cmp.Compare(boolToInt(first), boolToInt(second))
+	}
+
+	switch c.GetType().GetTypeID() {
+	case cltype.TypeIDBool:
+		return cmp.Compare(boolToInt(bool(*c.Bool)), boolToInt(bool(*other.Bool)))
+	case cltype.TypeIDI32:
+		return cmp.Compare(*c.I32, *other.I32)
+	case cltype.TypeIDI64:
+		return cmp.Compare(*c.I64, *other.I64)
+	case cltype.TypeIDU8:
+		return cmp.Compare(*c.UI8, *other.UI8)
+	case cltype.TypeIDU32:
+		return cmp.Compare(*c.UI32, *other.UI32)
+	case cltype.TypeIDU64:
+		return cmp.Compare(*c.UI64, *other.UI64)
+	case cltype.TypeIDU128, cltype.TypeIDU256, cltype.TypeIDU512:
+		first, _ := bigIntValue(c)
+		second, _ := bigIntValue(other)
+		return first.Cmp(second)
+	case cltype.TypeIDUnit:
+		return 0
+	case cltype.TypeIDString:
+		return strings.Compare(c.StringVal.String(), other.StringVal.String())
+	case cltype.TypeIDKey:
+		return compareKeys(*c.Key, *other.Key)
+	case cltype.TypeIDOption:
+		if c.Option.IsEmpty() || other.Option.IsEmpty() {
+			return cmp.Compare(boolToInt(!c.Option.IsEmpty()), boolToInt(!other.Option.IsEmpty()))
+		}
+		return c.Option.Inner.Compare(*other.Option.Inner)
+	case cltype.TypeIDList:
+		return compareSequences(c.List.Elements, other.List.Elements)
+	case cltype.TypeIDResult:
+		// Ok is declared before Err in the Rust Result
+		if c.Result.IsSuccess != other.Result.IsSuccess {
+			return cmp.Compare(boolToInt(!c.Result.IsSuccess), boolToInt(!other.Result.IsSuccess))
+		}
+		return c.Result.Inner.Compare(other.Result.Inner)
+	case cltype.TypeIDMap:
+		return compareSequences(sortedMapEntries(c.Map), sortedMapEntries(other.Map))
+	case cltype.TypeIDTuple1:
+		return c.Tuple1.Value().Compare(other.Tuple1.Value())
+	case cltype.TypeIDTuple2:
+		return compareSequences([]CLValue{c.Tuple2.Inner1, c.Tuple2.Inner2}, []CLValue{other.Tuple2.Inner1, other.Tuple2.Inner2})
+	case cltype.TypeIDTuple3:
+		return compareSequences(
+			[]CLValue{c.Tuple3.Inner1, c.Tuple3.Inner2, c.Tuple3.Inner3},
+			[]CLValue{other.Tuple3.Inner1, other.Tuple3.Inner2, other.Tuple3.Inner3},
+		)
+	}
+	// URef, PublicKey, ByteArray and Any are ordered by their bytes, the tag of PublicKey goes first
+	return bytes.Compare(c.Bytes(), other.Bytes())
+}
+
+// hasPayload reports whether the field of the value's type is set, Unit has no payload to check.
+func (c CLValue) hasPayload() bool {
+	switch c.GetType().GetTypeID() {
+	case cltype.TypeIDBool:
+		return c.Bool != nil
+	case cltype.TypeIDI32:
+		return c.I32 != nil
+	case cltype.TypeIDI64:
+		return c.I64 != nil
+	case cltype.TypeIDU8:
+		return c.UI8 != nil
+	case cltype.TypeIDU32:
+		return c.UI32 != nil
+	case cltype.TypeIDU64:
+		return c.UI64 != nil
+	case cltype.TypeIDU128:
+		return c.UI128 != nil && c.UI128.Value() != nil
+	case cltype.TypeIDU256:
+		return c.UI256 != nil && c.UI256.Value() != nil
+	case cltype.TypeIDU512:
+		return c.UI512 != nil && c.UI512.Value() != nil
+	case cltype.TypeIDString:
+		return c.StringVal != nil
+	case cltype.TypeIDKey:
+		return c.Key != nil
+	case cltype.TypeIDURef:
+		return c.Uref != nil
+	case cltype.TypeIDPublicKey:
+		return c.PublicKey != nil
+	case cltype.TypeIDByteArray:
+		return c.ByteArray != nil
+	case cltype.TypeIDAny:
+		return c.Any != nil
+	case cltype.TypeIDOption:
+		return c.Option != nil
+	case cltype.TypeIDList:
+		return c.List != nil
+	case cltype.TypeIDResult:
+		return c.Result != nil
+	case cltype.TypeIDMap:
+		return c.Map != nil
+	case cltype.TypeIDTuple1:
+		return c.Tuple1 != nil
+	case cltype.TypeIDTuple2:
+		return c.Tuple2 != nil
+	case cltype.TypeIDTuple3:
+		return c.Tuple3 != nil
+	}
+	return true
+}
+
+// compareKeys orders the keys as the derived Ord of the Rust Key does: by the variant and then field by field.
+func compareKeys(first, second key.Key) int {
+	return bytes.Compare(keyOrderBytes(first), keyOrderBytes(second))
+}
+
+// keyOrderBytes returns the bytes of the key with the integer fields encoded in big-endian, so they are compared as numbers.
+// The other fields are compared by their bytes the same way as the fields of the Rust Key.
+func keyOrderBytes(k key.Key) []byte {
+	result := []byte{k.Type}
+	switch {
+	case k.Type == key.TypeIDEraId && k.Era != nil:
+		return binary.BigEndian.AppendUint64(result, uint64(*k.Era))
+	case k.Type == key.TypeIDBidAddr && k.BidAddr != nil && k.BidAddr.Credit != nil:
+		data := k.BidAddr.Bytes()
+		// the tag of BidAddr and the validator are followed by the era in little-endian
+		result = append(result, data[:len(data)-8]...)
+		return binary.BigEndian.AppendUint64(result, k.BidAddr.Credit.EraId)
+	case k.Type == key.TypeIDBalanceHold && k.BalanceHold != nil:
+		data := k.BalanceHold.Bytes()
+		// the tag of BalanceHoldAddr and the purse are followed by the block time in little-endian
+		result = append(result, data[:len(data)-key.BlockTypeBytesLen]...)
+		return binary.BigEndian.AppendUint64(result, binary.LittleEndian.Uint64(data[len(data)-key.BlockTypeBytesLen:]))
+	case k.Type == key.TypeIDMessage && k.Message != nil:
+		result = append(result, k.Message.EntityAddr.Bytes()...)
+		result = append(result, k.Message.TopicNameHash.Bytes()...)
+		// the message index is Option<u32>, the topic without the index goes first
+		if k.Message.MessageIndex == nil {
+			return append(result, 0)
+		}
+		return binary.BigEndian.AppendUint32(append(result, 1), *k.Message.MessageIndex)
+	}
+	return k.Bytes()
+}
+
+func compareSequences(first, second []CLValue) int {
+	for i := 0; i < len(first) && i < len(second); i++ {
+		if result := first[i].Compare(second[i]); result != 0 {
+			return result
+		}
+	}
+	return cmp.Compare(len(first), len(second))
+}
+
+// sortedMapEntries returns the keys and the values of the Map interleaved and ordered by the keys.
+func sortedMapEntries(m *Map) []CLValue {
+	data := m.Data()
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Inner1.Compare(data[j].Inner1) < 0
+	})
+	result := make([]CLValue, 0, 2*len(data))
+	for _, pair := range data {
+		result = append(result, pair.Inner1, pair.Inner2)
+	}
+	return result
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -4,10 +4,15 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
 )
 
+var ErrDuplicateMapKey = errors.New("map key is already exist")
+
+// Map keeps its entries sorted by the keys in the order of CLValue.Compare,
+// the same way as the BTreeMap of casper-types, so the bytes of the Map match the ones produced by the node.
 type Map struct {
 	Type        *cltype.Map
 	data        []Tuple2
@@ -40,8 +45,8 @@ func (m *Map) Data() []Tuple2 {
 
 func (m *Map) String() string {
 	b := new(bytes.Buffer)
-	for key, value := range m.indexedData {
-		fmt.Fprintf(b, "(%s=\"%s\")", key, value.String())
+	for _, pair := range m.data {
+		fmt.Fprintf(b, "(%s=\"%s\")", pair.Inner1.String(), pair.Inner2.String())
 	}
 	return b.String()
 }
@@ -69,6 +74,7 @@ func (m *Map) Len() int {
 	return len(m.indexedData)
 }
 
+// Append inserts the entry at the position of its key in the canonical order, the duplicated key and the values without their payload are rejected.
 func (m *Map) Append(key CLValue, val CLValue) error {
 	if key.Type != m.Type.Key {
 		return errors.New("invalid key type")
@@ -76,10 +82,18 @@ func (m *Map) Append(key CLValue, val CLValue) error {
 	if val.Type != m.Type.Val {
 		return errors.New("invalid value type")
 	}
-	if _, found := m.indexedData[key.String()]; found {
-		return errors.New("map key is already exist")
+	if !key.hasPayload() || !val.hasPayload() {
+		return fmt.Errorf("%w, type: %s", ErrEmptyCLValue, m.Type.String())
+	}
+	index := sort.Search(len(m.data), func(i int) bool {
+		return m.data[i].Inner1.Compare(key) >= 0
+	})
+	if _, found := m.indexedData[key.String()]; found || (index < len(m.data) && m.data[index].Inner1.Equals(key)) {
+		return fmt.Errorf("%w, key: %s", ErrDuplicateMapKey, key.String())
 	}
-	m.data = append(m.data, *NewCLTuple2(key, val).Tuple2)
+	m.data = append(m.data, Tuple2{})
+	copy(m.data[index+1:], m.data[index:])
+	m.data[index] = *NewCLTuple2(key, val).Tuple2
 	m.indexedData[key.String()] = val
 	return nil
 }
@@ -100,6 +114,8 @@ func newMap(mapType *cltype.Map) *Map {
 	}
 }
 
+// NewMapFromBuffer decodes the Map putting its entries in the canonical order, the duplicated keys are rejected.
+// Use FromBytesByTypeStrict to reject the bytes of the Map which entries are not in the canonical order.
 func NewMapFromBuffer(buffer *bytes.Buffer, mapType *cltype.Map) (*Map, error) {
 	result := newMap(mapType)
 	var KeyVal CLValue
@@ -0,0 +1,626 @@
+package clvalue
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+// TagName is the struct tag configuring the marshalling of the field: `cl:"name,option,..."`.
+// The name is used as the argument name by MarshalFields, the options are:
+// u128, u256 or u512 to encode the unsigned integer or *big.Int with the type (U512 is the default for *big.Int),
+// omitempty to skip the nil field in MarshalFields, "-" as the name skips the field.
+const TagName = "cl"
+
+var (
+	ErrUnsupportedGoType    = errors.New("go type is not supported by CLValue marshalling")
+	ErrInvalidUnmarshalType = errors.New("unmarshal target must be a non-nil pointer")
+	ErrCLTypeMismatch       = errors.New("CLValue type doesn't match the go type")
+	ErrValueOverflow        = errors.New("value overflows the type")
+)
+
+var (
+	bigIntType    = reflect.TypeOf(big.Int{})
+	keyType       = reflect.TypeOf(key.Key{})
+	urefType      = reflect.TypeOf(key.URef{})
+	publicKeyType = reflect.TypeOf(keypair.PublicKey{})
+	clValueType   = reflect.TypeOf(CLValue{})
+	unitType      = reflect.TypeOf(struct{}{})
+)
+
+var bigIntBits = map[cltype.TypeID]int{
+	cltype.TypeIDU128: 128,
+	cltype.TypeIDU256: 256,
+	cltype.TypeIDU512: 512,
+}
+
+// NamedValue is the CLValue of the struct field with the name from its tag.
+type NamedValue struct {
+	Name  string
+	Value CLValue
+}
+
+type fieldTag struct {
+	name      string
+	bigType   cltype.CLType
+	omitEmpty bool
+	skip      bool
+}
+
+// Marshal converts the Go value into the CLValue:
+// bool, string, integers (int32 as I32, int64 as I64, uint8 as U8, uint32 as U32, uint64 as U64),
+// *big.Int as U512, slices as List, arrays of bytes as ByteArray, maps as Map, pointers as Option,
+// structs of 1 to 3 fields as Tuple, struct{} as Unit and key.Key, key.URef, keypair.PublicKey as themselves.
+// A CLValue is returned as is.
+func Marshal(v any) (CLValue, error) {
+	if v == nil {
+		return CLValue{}, fmt.Errorf("%w, details: nil value", ErrUnsupportedGoType)
+	}
+	value := reflect.ValueOf(v)
+	clType, err := clTypeOf(value.Type(), fieldTag{})
+	if err != nil {
+		return CLValue{}, err
+	}
+	return marshalValue(value, clType)
+}
+
+// MarshalFields converts every exported field of the struct into the CLValue named by the field tag,
+// the field name is used if the tag has no name.
+func MarshalFields(v any) ([]NamedValue, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w, details: struct is expected, got %T", ErrUnsupportedGoType, v)
+	}
+
+	result := make([]NamedValue, 0, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := parseFieldTag(field)
+		if tag.skip {
+			continue
+		}
+		fieldValue := value.Field(i)
+		if tag.omitEmpty && isNilValue(fieldValue) {
+			continue
+		}
+		clType, err := clTypeOf(field.Type, tag)
+		if err != nil {
+			return nil, fmt.Errorf("%w, field: %s", err, field.Name)
+		}
+		clValue, err := marshalValue(fieldValue, clType)
+		if err != nil {
+			return nil, fmt.Errorf("%w, field: %s", err, field.Name)
+		}
+		result = append(result, NamedValue{Name: tag.name, Value: clValue})
+	}
+	return result, nil
+}
+
+// Unmarshal stores the CLValue in the value pointed to by target, following the type mapping of Marshal.
+// Option is stored as nil or the pointer to the value, the integers are checked for overflow.
+func Unmarshal(value CLValue, target any) error {
+	pointer := reflect.ValueOf(target)
+	if pointer.Kind() != reflect.Pointer || pointer.IsNil() {
+		return fmt.Errorf("%w, got %T", ErrInvalidUnmarshalType, target)
+	}
+	return unmarshalValue(value, pointer.Elem())
+}
+
+func parseFieldTag(field reflect.StructField) fieldTag {
+	result := fieldTag{name: field.Name}
+	tag, ok := field.Tag.Lookup(TagName)
+	if !ok {
+		return result
+	}
+	parts := strings.Split(tag, ",")
+	if parts[0] == "-" && len(parts) == 1 {
+		result.skip = true
+		return result
+	}
+	if parts[0] != "" {
+		result.name = parts[0]
+	}
+	for _, option := range parts[1:] {
+		switch option {
+		case "u128":
+			result.bigType = cltype.UInt128
+		case "u256":
+			result.bigType = cltype.UInt256
+		case "u512":
+			result.bigType = cltype.UInt512
+		case "omitempty":
+			result.omitEmpty = true
+		}
+	}
+	return result
+}
+
+func isNilValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return value.IsNil()
+	}
+	return false
+}
+
+// clTypeOf resolves the CLType of the Go type, the tag applies to the numbers of the type and its elements.
+func clTypeOf(goType reflect.Type, tag fieldTag) (cltype.CLType, error) {
+	switch goType {
+	case bigIntType:
+		return nil, fmt.Errorf("%w, details: use *big.Int instead of big.Int", ErrUnsupportedGoType)
+	case keyType:
+		return cltype.Key, nil
+	case urefType:
+		return cltype.Uref, nil
+	case publicKeyType:
+		return cltype.PublicKey, nil
+	case unitType:
+		return cltype.Unit, nil
+	}
+	if goType.Kind() == reflect.Pointer && goType.Elem() == bigIntType {
+		if tag.bigType != nil {
+			return tag.bigType, nil
+		}
+		return cltype.UInt512, nil
+	}
+
+	switch goType.Kind() {
+	case reflect.Bool:
+		return cltype.Bool, nil
+	case reflect.String:
+		return cltype.String, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return cltype.Int32, nil
+	case reflect.Int, reflect.Int64:
+		return cltype.Int64, nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint, reflect.Uint64:
+		if tag.bigType != nil {
+			return tag.bigType, nil
+		}
+		switch goType.Kind() {
+		case reflect.Uint8:
+			return cltype.UInt8, nil
+		case reflect.Uint16, reflect.Uint32:
+			return cltype.UInt32, nil
+		}
+		return cltype.UInt64, nil
+	case reflect.Pointer:
+		inner, err := elementTypeOf(goType.Elem(), tag)
+		if err != nil {
+			return nil, err
+		}
+		return cltype.NewOptionType(inner), nil
+	case reflect.Slice:
+		inner, err := elementTypeOf(goType.Elem(), tag)
+		if err != nil {
+			return nil, err
+		}
+		return cltype.NewList(inner), nil
+	case reflect.Array:
+		if goType.Elem().Kind() != reflect.Uint8 {
+			return nil, fmt.Errorf("%w, details: only byte arrays are supported, got %s", ErrUnsupportedGoType, goType)
+		}
+		return cltype.NewByteArray(uint32(goType.Len())), nil
+	case reflect.Map:
+		keyCLType, err := elementTypeOf(goType.Key(), tag)
+		if err != nil {
+			return nil, err
+		}
+		valCLType, err := elementTypeOf(goType.Elem(), tag)
+		if err != nil {
+			return nil, err
+		}
+		return cltype.NewMap(keyCLType, valCLType), nil
+	case reflect.Struct:
+		if goType == clValueType {
+			return nil, nil
+		}
+		return tupleTypeOf(goType)
+	}
+	return nil, fmt.Errorf("%w, details: %s", ErrUnsupportedGoType, goType)
+}
+
+// elementTypeOf resolves the type of the element of the complex type, which has to be known statically.
+func elementTypeOf(goType reflect.Type, tag fieldTag) (cltype.CLType, error) {
+	clType, err := clTypeOf(goType, tag)
+	if err != nil {
+		return nil, err
+	}
+	if clType == nil {
+		return nil, fmt.Errorf("%w, details: CLValue can't be the element of the complex type", ErrUnsupportedGoType)
+	}
+	return clType, nil
+}
+
+func tupleTypeOf(goType reflect.Type) (cltype.CLType, error) {
+	fields, err := tupleFields(goType)
+	if err != nil {
+		return nil, err
+	}
+	inner := make([]cltype.CLType, 0, len(fields))
+	for _, field := range fields {
+		fieldType, err := elementTypeOf(field.Type, parseFieldTag(field))
+		if err != nil {
+			return nil, fmt.Errorf("%w, field: %s", err, field.Name)
+		}
+		inner = append(inner, fieldType)
+	}
+	switch len(inner) {
+	case 1:
+		return cltype.NewTuple1(inner[0]), nil
+	case 2:
+		return cltype.NewTuple2(inner[0], inner[1]), nil
+	default:
+		return cltype.NewTuple3(inner[0], inner[1], inner[2]), nil
+	}
+}
+
+func tupleFields(goType reflect.Type) ([]reflect.StructField, error) {
+	var fields []reflect.StructField
+	for i := 0; i < goType.NumField(); i++ {
+		field := goType.Field(i)
+		if field.IsExported() && !parseFieldTag(field).skip {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 || len(fields) > 3 {
+		return nil, fmt.Errorf("%w, details: tuple struct must have 1 to 3 exported fields, got %s", ErrUnsupportedGoType, goType)
+	}
+	return fields, nil
+}
+
+// marshalValue builds the CLValue of the type resolved by clTypeOf, the nested values share the type instances
+// as Map and Result compare their element types by identity.
+func marshalValue(value reflect.Value, clType cltype.CLType) (CLValue, error) {
+	if clType == nil {
+		return value.Interface().(CLValue), nil
+	}
+
+	switch value.Type() {
+	case keyType:
+		data := value.Interface().(key.Key)
+		return CLValue{Type: clType, Key: &data}, nil
+	case urefType:
+		data := value.Interface().(key.URef)
+		return CLValue{Type: clType, Uref: &data}, nil
+	case publicKeyType:
+		data := value.Interface().(keypair.PublicKey)
+		return CLValue{Type: clType, PublicKey: &data}, nil
+	case unitType:
+		return *NewCLUnit(), nil
+	}
+
+	switch t := clType.(type) {
+	case *cltype.Option:
+		result := CLValue{Type: t, Option: &Option{Type: t}}
+		if value.IsNil() {
+			return result, nil
+		}
+		inner, err := marshalValue(value.Elem(), t.Inner)
+		if err != nil {
+			return CLValue{}, err
+		}
+		result.Option.Inner = &inner
+		return result, nil
+	case *cltype.List:
+		list := List{Type: t, Elements: make([]CLValue, 0, value.Len())}
+		for i := 0; i < value.Len(); i++ {
+			element, err := marshalValue(value.Index(i), t.ElementsType)
+			if err != nil {
+				return CLValue{}, err
+			}
+			list.Append(element)
+		}
+		return CLValue{Type: t, List: &list}, nil
+	case *cltype.ByteArray:
+		data := make(ByteArray, value.Len())
+		reflect.Copy(reflect.ValueOf([]byte(data)), value)
+		return CLValue{Type: t, ByteArray: &data}, nil
+	case *cltype.Map:
+		return marshalMap(value, t)
+	case *cltype.Tuple1, *cltype.Tuple2, *cltype.Tuple3:
+		return marshalTuple(value, t)
+	}
+
+	switch clType.GetTypeID() {
+	case cltype.TypeIDBool:
+		return NewCLBool(value.Bool()), nil
+	case cltype.TypeIDString:
+		return *NewCLString(value.String()), nil
+	case cltype.TypeIDI32:
+		return NewCLInt32(int32(value.Int())), nil
+	case cltype.TypeIDI64:
+		return *NewCLInt64(value.Int()), nil
+	case cltype.TypeIDU8:
+		return *NewCLUint8(uint8(value.Uint())), nil
+	case cltype.TypeIDU32:
+		return *NewCLUInt32(uint32(value.Uint())), nil
+	case cltype.TypeIDU64:
+		return *NewCLUInt64(value.Uint()), nil
+	case cltype.TypeIDU128, cltype.TypeIDU256, cltype.TypeIDU512:
+		return marshalBigInt(value, clType)
+	}
+	return CLValue{}, fmt.Errorf("%w, details: %s", ErrUnsupportedGoType, value.Type())
+}
+
+func marshalBigInt(value reflect.Value, clType cltype.CLType) (CLValue, error) {
+	var number *big.Int
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return CLValue{}, fmt.Errorf("%w, details: nil *big.Int", ErrUnsupportedGoType)
+		}
+		number = new(big.Int).Set(value.Interface().(*big.Int))
+	} else {
+		number = new(big.Int).SetUint64(value.Uint())
+	}
+	return newCLBigInt(number, clType)
+}
+
+// newCLBigInt creates the U128, U256 or U512 value, checking that the number fits the type.
+func newCLBigInt(number *big.Int, clType cltype.CLType) (CLValue, error) {
+	if number.Sign() < 0 || number.BitLen() > bigIntBits[clType.GetTypeID()] {
+		return CLValue{}, fmt.Errorf("%w, type: %s, value: %s", ErrValueOverflow, clType.Name(), number.String())
+	}
+
+	switch clType.GetTypeID() {
+	case cltype.TypeIDU128:
+		return *NewCLUInt128(number), nil
+	case cltype.TypeIDU256:
+		return *NewCLUInt256(number), nil
+	default:
+		return *NewCLUInt512(number), nil
+	}
+}
+
+func marshalMap(value reflect.Value, mapType *cltype.Map) (CLValue, error) {
+	result := newMap(mapType)
+	for _, one := range value.MapKeys() {
+		keyValue, err := marshalValue(one, mapType.Key)
+		if err != nil {
+			return CLValue{}, err
+		}
+		val, err := marshalValue(value.MapIndex(one), mapType.Val)
+		if err != nil {
+			return CLValue{}, err
+		}
+		if err = result.Append(keyValue, val); err != nil {
+			return CLValue{}, err
+		}
+	}
+	return CLValue{Type: mapType, Map: result}, nil
+}
+
+func marshalTuple(value reflect.Value, clType cltype.CLType) (CLValue, error) {
+	fields, err := tupleFields(value.Type())
+	if err != nil {
+		return CLValue{}, err
+	}
+	var inner []cltype.CLType
+	switch t := clType.(type) {
+	case *cltype.Tuple1:
+		inner = []cltype.CLType{t.Inner}
+	case *cltype.Tuple2:
+		inner = []cltype.CLType{t.Inner1, t.Inner2}
+	case *cltype.Tuple3:
+		inner = []cltype.CLType{t.Inner1, t.Inner2, t.Inner3}
+	}
+
+	elements := make([]CLValue, len(fields))
+	for i, field := range fields {
+		if elements[i], err = marshalValue(value.FieldByIndex(field.Index), inner[i]); err != nil {
+			return CLValue{}, fmt.Errorf("%w, field: %s", err, field.Name)
+		}
+	}
+
+	switch t := clType.(type) {
+	case *cltype.Tuple1:
+		return CLValue{Type: t, Tuple1: &Tuple1{innerType: t, innerVal: elements[0]}}, nil
+	case *cltype.Tuple2:
+		return CLValue{Type: t, Tuple2: &Tuple2{innerType: t, Inner1: elements[0], Inner2: elements[1]}}, nil
+	default:
+		t3 := clType.(*cltype.Tuple3)
+		return CLValue{Type: t3, Tuple3: &Tuple3{innerType: t3, Inner1: elements[0], Inner2: elements[1], Inner3: elements[2]}}, nil
+	}
+}
+
+func unmarshalValue(value CLValue, target reflect.Value) error {
+	switch target.Type() {
+	case clValueType:
+		target.Set(reflect.ValueOf(value))
+		return nil
+	case keyType:
+		if value.Key == nil {
+			return typeMismatch(value, target)
+		}
+		target.Set(reflect.ValueOf(*value.Key))
+		return nil
+	case urefType:
+		if value.Uref == nil {
+			return typeMismatch(value, target)
+		}
+		target.Set(reflect.ValueOf(*value.Uref))
+		return nil
+	case publicKeyType:
+		if value.PublicKey == nil {
+			return typeMismatch(value, target)
+		}
+		target.Set(reflect.ValueOf(*value.PublicKey))
+		return nil
+	case unitType:
+		if value.Unit == nil {
+			return typeMismatch(value, target)
+		}
+		return nil
+	}
+	if target.Kind() == reflect.Pointer && target.Type().Elem() == bigIntType {
+		number, err := bigIntValue(value)
+		if err != nil {
+			return typeMismatch(value, target)
+		}
+		target.Set(reflect.ValueOf(new(big.Int).Set(number)))
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Bool:
+		if value.Bool == nil {
+			return typeMismatch(value, target)
+		}
+		target.SetBool(value.Bool.Value())
+	case reflect.String:
+		if value.StringVal == nil {
+			return typeMismatch(value, target)
+		}
+		target.SetString(value.StringVal.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var number int64
+		switch {
+		case value.I32 != nil:
+			number = int64(value.I32.Value())
+		case value.I64 != nil:
+			number = value.I64.Value()
+		default:
+			return typeMismatch(value, target)
+		}
+		if target.OverflowInt(number) {
+			return fmt.Errorf("%w, type: %s, value: %d", ErrValueOverflow, target.Type(), number)
+		}
+		target.SetInt(number)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return unmarshalUint(value, target)
+	case reflect.Pointer:
+		if value.Option == nil {
+			return typeMismatch(value, target)
+		}
+		if value.Option.IsEmpty() {
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+		inner := reflect.New(target.Type().Elem())
+		if err := unmarshalValue(*value.Option.Inner, inner.Elem()); err != nil {
+			return err
+		}
+		target.Set(inner)
+	case reflect.Slice:
+		if value.List == nil {
+			return typeMismatch(value, target)
+		}
+		result := reflect.MakeSlice(target.Type(), value.List.Len(), value.List.Len())
+		for i, element := range value.List.Elements {
+			if err := unmarshalValue(element, result.Index(i)); err != nil {
+				return err
+			}
+		}
+		target.Set(result)
+	case reflect.Array:
+		if value.ByteArray == nil || target.Type().Elem().Kind() != reflect.Uint8 {
+			return typeMismatch(value, target)
+		}
+		if len(*value.ByteArray) != target.Len() {
+			return fmt.Errorf("%w, details: ByteArray of %d bytes into %s", ErrCLTypeMismatch, len(*value.ByteArray), target.Type())
+		}
+		reflect.Copy(target, reflect.ValueOf([]byte(*value.ByteArray)))
+	case reflect.Map:
+		if value.Map == nil {
+			return typeMismatch(value, target)
+		}
+		result := reflect.MakeMapWithSize(target.Type(), value.Map.Len())
+		for _, pair := range value.Map.Data() {
+			mapKey := reflect.New(target.Type().Key()).Elem()
+			if err := unmarshalValue(pair.Inner1, mapKey); err != nil {
+				return err
+			}
+			mapVal := reflect.New(target.Type().Elem()).Elem()
+			if err := unmarshalValue(pair.Inner2, mapVal); err != nil {
+				return err
+			}
+			result.SetMapIndex(mapKey, mapVal)
+		}
+		target.Set(result)
+	case reflect.Struct:
+		return unmarshalTuple(value, target)
+	default:
+		return fmt.Errorf("%w, details: %s", ErrUnsupportedGoType, target.Type())
+	}
+	return nil
+}
+
+func unmarshalUint(value CLValue, target reflect.Value) error {
+	var number uint64
+	switch {
+	case value.UI8 != nil:
+		number = uint64(value.UI8.Value())
+	case value.UI32 != nil:
+		number = uint64(value.UI32.Value())
+	case value.UI64 != nil:
+		number = value.UI64.Value()
+	default:
+		bigNumber, err := bigIntValue(value)
+		if err != nil {
+			return typeMismatch(value, target)
+		}
+		if !bigNumber.IsUint64() {
+			return fmt.Errorf("%w, type: %s, value: %s", ErrValueOverflow, target.Type(), bigNumber.String())
+		}
+		number = bigNumber.Uint64()
+	}
+	if target.OverflowUint(number) {
+		return fmt.Errorf("%w, type: %s, value: %d", ErrValueOverflow, target.Type(), number)
+	}
+	target.SetUint(number)
+	return nil
+}
+
+func unmarshalTuple(value CLValue, target reflect.Value) error {
+	fields, err := tupleFields(target.Type())
+	if err != nil {
+		return err
+	}
+	var elements []CLValue
+	switch {
+	case value.Tuple1 != nil:
+		elements = []CLValue{value.Tuple1.Value()}
+	case value.Tuple2 != nil:
+		elements = []CLValue{value.Tuple2.Inner1, value.Tuple2.Inner2}
+	case value.Tuple3 != nil:
+		elements = []CLValue{value.Tuple3.Inner1, value.Tuple3.Inner2, value.Tuple3.Inner3}
+	}
+	if len(elements) != len(fields) {
+		return typeMismatch(value, target)
+	}
+	for i, field := range fields {
+		if err = unmarshalValue(elements[i], target.FieldByIndex(field.Index)); err != nil {
+			return fmt.Errorf("%w, field: %s", err, field.Name)
+		}
+	}
+	return nil
+}
+
+func bigIntValue(value CLValue) (*big.Int, error) {
+	switch {
+	case value.UI128 != nil:
+		return value.UI128.Value(), nil
+	case value.UI256 != nil:
+		return value.UI256.Value(), nil
+	case value.UI512 != nil:
+		return value.UI512.Value(), nil
+	}
+	return nil, ErrCLTypeMismatch
+}
+
+func typeMismatch(value CLValue, target reflect.Value) error {
+	typeName := "<nil>"
+	if value.Type != nil {
+		typeName = value.Type.String()
+	}
+	return fmt.Errorf("%w, details: %s into %s", ErrCLTypeMismatch, typeName, target.Type())
+}
@@ -0,0 +1,159 @@
+package clvalue
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+)
+
+// NativeResult is the Go form of the Result value returned by ToNative.
+type NativeResult struct {
+	IsSuccess bool
+	Value     any
+}
+
+// ToNative converts the value into the plain Go value: bool, int32, int64, uint8, uint32, uint64,
+// *big.Int for U128, U256 and U512, nil for Unit and the empty Option, string, key.Key, key.URef, keypair.PublicKey,
+// []byte for ByteArray and Any, []any for List and Tuple, NativeResult for Result
+// and map[string]any for Map, keyed by the String of the keys as Map.Map does.
+func (c CLValue) ToNative() (any, error) {
+	if _, err := c.GetValueByType(); err != nil {
+		return nil, err
+	}
+	empty := fmt.Errorf("%w, type: %s", ErrEmptyCLValue, c.Type.String())
+
+	switch c.GetType().GetTypeID() {
+	case cltype.TypeIDBool:
+		if c.Bool == nil {
+			return nil, empty
+		}
+		return c.Bool.Value(), nil
+	case cltype.TypeIDI32:
+		if c.I32 == nil {
+			return nil, empty
+		}
+		return c.I32.Value(), nil
+	case cltype.TypeIDI64:
+		if c.I64 == nil {
+			return nil, empty
+		}
+		return c.I64.Value(), nil
+	case cltype.TypeIDU8:
+		if c.UI8 == nil {
+			return nil, empty
+		}
+		return c.UI8.Value(), nil
+	case cltype.TypeIDU32:
+		if c.UI32 == nil {
+			return nil, empty
+		}
+		return c.UI32.Value(), nil
+	case cltype.TypeIDU64:
+		if c.UI64 == nil {
+			return nil, empty
+		}
+		return c.UI64.Value(), nil
+	case cltype.TypeIDU128, cltype.TypeIDU256, cltype.TypeIDU512:
+		number, err := bigIntValue(c)
+		if err != nil {
+			return nil, empty
+		}
+		return new(big.Int).Set(number), nil
+	case cltype.TypeIDUnit:
+		return nil, nil
+	case cltype.TypeIDString:
+		if c.StringVal == nil {
+			return nil, empty
+		}
+		return c.StringVal.String(), nil
+	case cltype.TypeIDKey:
+		if c.Key == nil {
+			return nil, empty
+		}
+		return *c.Key, nil
+	case cltype.TypeIDURef:
+		if c.Uref == nil {
+			return nil, empty
+		}
+		return *c.Uref, nil
+	case cltype.TypeIDPublicKey:
+		if c.PublicKey == nil {
+			return nil, empty
+		}
+		return *c.PublicKey, nil
+	case cltype.TypeIDByteArray:
+		if c.ByteArray == nil {
+			return nil, empty
+		}
+		return append([]byte{}, *c.ByteArray...), nil
+	case cltype.TypeIDAny:
+		if c.Any == nil {
+			return nil, empty
+		}
+		return append([]byte{}, *c.Any...), nil
+	case cltype.TypeIDOption:
+		if c.Option == nil {
+			return nil, empty
+		}
+		if c.Option.IsEmpty() {
+			return nil, nil
+		}
+		return c.Option.Inner.ToNative()
+	case cltype.TypeIDList:
+		if c.List == nil {
+			return nil, empty
+		}
+		return nativeValues(c.List.Elements...)
+	case cltype.TypeIDResult:
+		if c.Result == nil {
+			return nil, empty
+		}
+		inner, err := c.Result.Inner.ToNative()
+		if err != nil {
+			return nil, err
+		}
+		return NativeResult{IsSuccess: c.Result.IsSuccess, Value: inner}, nil
+	case cltype.TypeIDMap:
+		if c.Map == nil {
+			return nil, empty
+		}
+		result := make(map[string]any, c.Map.Len())
+		for _, pair := range c.Map.Data() {
+			value, err := pair.Inner2.ToNative()
+			if err != nil {
+				return nil, err
+			}
+			result[pair.Inner1.String()] = value
+		}
+		return result, nil
+	case cltype.TypeIDTuple1:
+		if c.Tuple1 == nil {
+			return nil, empty
+		}
+		return nativeValues(c.Tuple1.Value())
+	case cltype.TypeIDTuple2:
+		if c.Tuple2 == nil {
+			return nil, empty
+		}
+		return nativeValues(c.Tuple2.Inner1, c.Tuple2.Inner2)
+	case cltype.TypeIDTuple3:
+		if c.Tuple3 == nil {
+			return nil, empty
+		}
+		return nativeValues(c.Tuple3.Inner1, c.Tuple3.Inner2, c.Tuple3.Inner3)
+	}
+	return nil, fmt.Errorf("%w, type: %s", ErrUnknownCLType, c.Type.String())
+}
+
+func nativeValues(values ...CLValue) ([]any, error) {
+	result := make([]any, 0, len(values))
+	for _, one := range values {
+		native, err := one.ToNative()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, native)
+	}
+	return result, nil
+}
@@ -0,0 +1,250 @@
+package clvalue
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+)
+
+var (
+	ErrAmbiguousParsedValue = errors.New("parsed JSON of the type is ambiguous")
+	ErrEmptyCLValue         = errors.New("CLValue has no value of its type")
+
+	// errNoParsedRepresentation makes the whole value rendered as null, as the node does for the values containing Any
+	errNoParsedRepresentation = errors.New("value has no parsed representation")
+)
+
+// ToParsedJSON renders the value in the human-readable form of the "parsed" field returned by the node:
+// the numbers up to U64 as JSON numbers, U128, U256 and U512 as decimal strings, Unit, the empty Option and Any as null,
+// Key and URef as the formatted strings, PublicKey and ByteArray as hex, List and Tuple as arrays,
+// Result as {"Ok": value} or {"Err": value} and Map as the array of {"key": key, "value": value} objects.
+// The value containing Any is rendered as null.
+func (c CLValue) ToParsedJSON() (json.RawMessage, error) {
+	parsed, err := c.parsedValue()
+	if errors.Is(err, errNoParsedRepresentation) {
+		return json.RawMessage("null"), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(parsed)
+}
+
+// NewCLValueFromParsed builds the value of the type from its "parsed" JSON representation, see CLValue.ToParsedJSON.
+// The types which values can't be told apart by the parsed JSON are rejected with ErrAmbiguousParsedValue:
+// Any and Option of Unit or of another Option, both Some and None of which are rendered as null.
+func NewCLValueFromParsed(clType cltype.CLType, parsed json.RawMessage) (CLValue, error) {
+	if err := checkParsedUnambiguous(clType); err != nil {
+		return CLValue{}, err
+	}
+	return NewCLValueFromJSON(clType, parsed)
+}
+
+// NewCLValueFromParsedJSON builds the value from the JSON object with the cl_type and parsed fields, e.g. an argument returned by the node.
+func NewCLValueFromParsedJSON(source json.RawMessage) (CLValue, error) {
+	var data struct {
+		CLType json.RawMessage `json:"cl_type"`
+		Parsed json.RawMessage `json:"parsed"`
+	}
+	if err := json.Unmarshal(source, &data); err != nil {
+		return CLValue{}, err
+	}
+	clType, err := cltype.FromRawJson(data.CLType)
+	if err != nil {
+		return CLValue{}, err
+	}
+	if len(data.Parsed) == 0 {
+		data.Parsed = json.RawMessage("null")
+	}
+	return NewCLValueFromParsed(clType, data.Parsed)
+}
+
+func checkParsedUnambiguous(clType cltype.CLType) error {
+	switch t := clType.(type) {
+	case *cltype.Option:
+		switch t.Inner.GetTypeID() {
+		case cltype.TypeIDUnit, cltype.TypeIDOption, cltype.TypeIDAny:
+			return fmt.Errorf("%w, type: %s", ErrAmbiguousParsedValue, clType.String())
+		}
+		return checkParsedUnambiguous(t.Inner)
+	case *cltype.List:
+		return checkParsedUnambiguous(t.ElementsType)
+	case *cltype.Map:
+		if err := checkParsedUnambiguous(t.Key); err != nil {
+			return err
+		}
+		return checkParsedUnambiguous(t.Val)
+	case *cltype.Result:
+		if err := checkParsedUnambiguous(t.InnerOk); err != nil {
+			return err
+		}
+		return checkParsedUnambiguous(t.InnerErr)
+	case *cltype.Tuple1:
+		return checkParsedUnambiguous(t.Inner)
+	case *cltype.Tuple2:
+		return checkAllParsedUnambiguous(t.Inner1, t.Inner2)
+	case *cltype.Tuple3:
+		return checkAllParsedUnambiguous(t.Inner1, t.Inner2, t.Inner3)
+	}
+	if clType.GetTypeID() == cltype.TypeIDAny {
+		return fmt.Errorf("%w, type: %s", ErrAmbiguousParsedValue, clType.String())
+	}
+	return nil
+}
+
+func checkAllParsedUnambiguous(types ...cltype.CLType) error {
+	for _, one := range types {
+		if err := checkParsedUnambiguous(one); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parsedKeyValue is the element of the parsed Map.
+type parsedKeyValue struct {
+	Key   any `json:"key"`
+	Value any `json:"value"`
+}
+
+func (c CLValue) parsedValue() (any, error) {
+	if c.Type == nil {
+		return nil, ErrEmptyCLValue
+	}
+	empty := fmt.Errorf("%w, type: %s", ErrEmptyCLValue, c.Type.String())
+
+	switch c.GetType().GetTypeID() {
+	case cltype.TypeIDBool:
+		if c.Bool == nil {
+			return nil, empty
+		}
+		return c.Bool.Value(), nil
+	case cltype.TypeIDI32:
+		if c.I32 == nil {
+			return nil, empty
+		}
+		return c.I32.Value(), nil
+	case cltype.TypeIDI64:
+		if c.I64 == nil {
+			return nil, empty
+		}
+		return c.I64.Value(), nil
+	case cltype.TypeIDU8:
+		if c.UI8 == nil {
+			return nil, empty
+		}
+		return c.UI8.Value(), nil
+	case cltype.TypeIDU32:
+		if c.UI32 == nil {
+			return nil, empty
+		}
+		return c.UI32.Value(), nil
+	case cltype.TypeIDU64:
+		if c.UI64 == nil {
+			return nil, empty
+		}
+		return c.UI64.Value(), nil
+	case cltype.TypeIDU128, cltype.TypeIDU256, cltype.TypeIDU512:
+		number, err := bigIntValue(c)
+		if err != nil {
+			return nil, empty
+		}
+		return number.String(), nil
+	case cltype.TypeIDUnit:
+		return nil, nil
+	case cltype.TypeIDString:
+		if c.StringVal == nil {
+			return nil, empty
+		}
+		return c.StringVal.String(), nil
+	case cltype.TypeIDKey:
+		if c.Key == nil {
+			return nil, empty
+		}
+		return c.Key.String(), nil
+	case cltype.TypeIDURef:
+		if c.Uref == nil {
+			return nil, empty
+		}
+		return c.Uref.String(), nil
+	case cltype.TypeIDPublicKey:
+		if c.PublicKey == nil {
+			return nil, empty
+		}
+		return c.PublicKey.ToHex(), nil
+	case cltype.TypeIDByteArray:
+		if c.ByteArray == nil {
+			return nil, empty
+		}
+		return hex.EncodeToString(*c.ByteArray), nil
+	case cltype.TypeIDOption:
+		if c.Option == nil {
+			return nil, empty
+		}
+		if c.Option.IsEmpty() {
+			return nil, nil
+		}
+		return c.Option.Inner.parsedValue()
+	case cltype.TypeIDList:
+		if c.List == nil {
+			return nil, empty
+		}
+		return parsedValues(c.List.Elements...)
+	case cltype.TypeIDResult:
+		if c.Result == nil {
+			return nil, empty
+		}
+		inner, err := c.Result.Inner.parsedValue()
+		if err != nil {
+			return nil, err
+		}
+		if c.Result.IsSuccess {
+			return map[string]any{"Ok": inner}, nil
+		}
+		return map[string]any{"Err": inner}, nil
+	case cltype.TypeIDMap:
+		if c.Map == nil {
+			return nil, empty
+		}
+		result := make([]parsedKeyValue, 0, c.Map.Len())
+		for _, pair := range c.Map.Data() {
+			parsed, err := parsedValues(pair.Inner1, pair.Inner2)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, parsedKeyValue{Key: parsed[0], Value: parsed[1]})
+		}
+		return result, nil
+	case cltype.TypeIDTuple1:
+		if c.Tuple1 == nil {
+			return nil, empty
+		}
+		return parsedValues(c.Tuple1.Value())
+	case cltype.TypeIDTuple2:
+		if c.Tuple2 == nil {
+			return nil, empty
+		}
+		return parsedValues(c.Tuple2.Inner1, c.Tuple2.Inner2)
+	case cltype.TypeIDTuple3:
+		if c.Tuple3 == nil {
+			return nil, empty
+		}
+		return parsedValues(c.Tuple3.Inner1, c.Tuple3.Inner2, c.Tuple3.Inner3)
+	}
+	return nil, errNoParsedRepresentation
+}
+
+func parsedValues(values ...CLValue) ([]any, error) {
+	result := make([]any, 0, len(values))
+	for _, one := range values {
+		parsed, err := one.parsedValue()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
@@ -11,6 +11,7 @@ import (
 
 var (
 	ErrUnsupportedCLType = errors.New("buffer constructor is not found")
+	ErrNonCanonicalBytes = errors.New("bytes are not in the canonical form")
 )
 
 func FromBytes(source []byte) (CLValue, []byte, error) {
@@ -60,6 +61,19 @@ func FromBytesByType(source []byte, clType cltype.CLType) (CLValue, error) {
 	return FromBufferByType(buf, clType)
 }
 
+// FromBytesByTypeStrict decodes the value as FromBytesByType does, but rejects with ErrNonCanonicalBytes the source
+// which is not exactly the bytes of the decoded value, e.g. the Map which entries are not sorted by the keys or the trailing bytes.
+func FromBytesByTypeStrict(source []byte, clType cltype.CLType) (CLValue, error) {
+	result, err := FromBytesByType(source, clType)
+	if err != nil {
+		return CLValue{}, err
+	}
+	if !bytes.Equal(result.Bytes(), source) {
+		return CLValue{}, ErrNonCanonicalBytes
+	}
+	return result, nil
+}
+
 func FromBufferByType(buf *bytes.Buffer, sourceType cltype.CLType) (result CLValue, err error) {
 	result.Type = sourceType
 	switch clType := result.Type.(type) {
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"golang.org/x/crypto/blake2b"
@@ -10,12 +12,37 @@ import (
 	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
 	"github.com/make-software/casper-go-sdk/v2/types/key"
 	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization/encoding"
 )
 
 var (
 	ErrInvalidDeployHash = errors.New("invalid deploy hash")
+	ErrTrailingBytes     = errors.New("unexpected trailing bytes")
 )
 
+// DecodingError reports the dot separated path to the field that failed to decode from bytes.
+type DecodingError struct {
+	Field string
+	Err   error
+}
+
+func (e *DecodingError) Error() string {
+	return fmt.Sprintf("failed to decode %s: %s", e.Field, e.Err.Error())
+}
+
+func (e *DecodingError) Unwrap() error {
+	return e.Err
+}
+
+// decodingError wraps the error with the field name, prepending it to the path of the nested DecodingError.
+func decodingError(field string, err error) error {
+	if nested, ok := err.(*DecodingError); ok {
+		return &DecodingError{Field: field + "." + nested.Field, Err: nested.Err}
+	}
+	return &DecodingError{Field: field, Err: err}
+}
+
 // Deploy is an item containing a smart contract along with the requester's signature(s).
 type Deploy struct {
 	// List of signers and signatures for this `deploy`.
@@ -76,6 +103,188 @@ func (d DeployHeader) Bytes() []byte {
 	return result
 }
 
+// DeployHeaderFromBytesDecoder decodes the DeployHeader from the representation produced by Bytes.
+type DeployHeaderFromBytesDecoder struct{}
+
+func (d *DeployHeaderFromBytesDecoder) FromBytes(source []byte) (*DeployHeader, []byte, error) {
+	account, remainder, err := (&keypair.PublicKeyFromBytesDecoder{}).FromBytes(source)
+	if err != nil {
+		return nil, nil, decodingError("account", err)
+	}
+	timestamp, remainder, err := (&TimestampFromBytesDecoder{}).FromBytes(remainder)
+	if err != nil {
+		return nil, nil, decodingError("timestamp", err)
+	}
+	ttl, remainder, err := (&DurationFromBytesDecoder{}).FromBytes(remainder)
+	if err != nil {
+		return nil, nil, decodingError("ttl", err)
+	}
+	gasPrice, remainder, err := (&encoding.U64FromBytesDecoder{}).FromBytes(remainder)
+	if err != nil {
+		return nil, nil, decodingError("gas_price", err)
+	}
+	bodyHash, remainder, err := decodeHash(remainder)
+	if err != nil {
+		return nil, nil, decodingError("body_hash", err)
+	}
+	count, remainder, err := encoding.NewU32FromBytesDecoder().FromBytes(remainder)
+	if err != nil {
+		return nil, nil, decodingError("dependencies", err)
+	}
+	dependencies := make([]key.Hash, 0)
+	for i := uint32(0); i < count; i++ {
+		var dependency key.Hash
+		if dependency, remainder, err = decodeHash(remainder); err != nil {
+			return nil, nil, decodingError(fmt.Sprintf("dependencies[%d]", i), err)
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	chainName, remainder, err := (&encoding.StringFromBytesDecoder{}).FromBytes(remainder)
+	if err != nil {
+		return nil, nil, decodingError("chain_name", err)
+	}
+
+	return &DeployHeader{
+		Account:      account,
+		BodyHash:     bodyHash,
+		ChainName:    chainName,
+		Dependencies: dependencies,
+		GasPrice:     gasPrice,
+		Timestamp:    *timestamp,
+		TTL:          *ttl,
+	}, remainder, nil
+}
+
+func (a Approval) Bytes() []byte {
+	return append(a.Signer.Bytes(), a.Signature...)
+}
+
+// ApprovalFromBytesDecoder decodes the Approval, the signature is prefixed with the algorithm tag of the signer.
+// The signature tagged with another algorithm is rejected with ErrInvalidApprovalSignature.
+type ApprovalFromBytesDecoder struct{}
+
+func (d *ApprovalFromBytesDecoder) FromBytes(source []byte) (Approval, []byte, error) {
+	signer, remainder, err := (&keypair.PublicKeyFromBytesDecoder{}).FromBytes(source)
+	if err != nil {
+		return Approval{}, nil, decodingError("signer", err)
+	}
+	// both supported algorithms produce 64 bytes signatures
+	if len(remainder) < 1+64 {
+		return Approval{}, nil, decodingError("signature", serialization.ErrEarlyEndOfStream)
+	}
+	if tag := remainder[0]; tag != signer.Algorithm().Byte() {
+		return Approval{}, nil, decodingError("signature", fmt.Errorf("%w, details: tag %d doesn't match the signer algorithm %s", ErrInvalidApprovalSignature, tag, signer.Algorithm()))
+	}
+	signature := make([]byte, 1+64)
+	copy(signature, remainder)
+	return Approval{Signer: signer, Signature: signature}, remainder[len(signature):], nil
+}
+
+// approvalsBytes serializes the approvals as the list prefixed with the number of items.
+func approvalsBytes(approvals []Approval) []byte {
+	result := clvalue.SizeToBytes(len(approvals))
+	for _, approval := range approvals {
+		result = append(result, approval.Bytes()...)
+	}
+	return result
+}
+
+// verifyApprovals checks the signatures of the transaction hash, many approvals are verified in parallel.
+func verifyApprovals(hash key.Hash, approvals []Approval) error {
+	items := make([]keypair.SignatureVerification, 0, len(approvals))
+	for _, approval := range approvals {
+		items = append(items, keypair.SignatureVerification{
+			PublicKey: approval.Signer,
+			Message:   hash.Bytes(),
+			Signature: approval.Signature,
+		})
+	}
+	var batchErr *keypair.BatchVerificationError
+	if err := keypair.VerifyBatch(items); errors.As(err, &batchErr) {
+		return fmt.Errorf("%w, approvals: %v", ErrInvalidApprovalSignature, batchErr.Failed)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+type approvalsFromBytesDecoder struct{}
+
+func (d *approvalsFromBytesDecoder) FromBytes(source []byte) ([]Approval, []byte, error) {
+	count, remainder, err := encoding.NewU32FromBytesDecoder().FromBytes(source)
+	if err != nil {
+		return nil, nil, decodingError("approvals", err)
+	}
+	approvals := make([]Approval, 0)
+	for i := uint32(0); i < count; i++ {
+		var approval Approval
+		if approval, remainder, err = (&ApprovalFromBytesDecoder{}).FromBytes(remainder); err != nil {
+			return nil, nil, decodingError(fmt.Sprintf("approvals[%d]", i), err)
+		}
+		approvals = append(approvals, approval)
+	}
+	return approvals, remainder, nil
+}
+
+// Bytes returns the binary representation of the Deploy: the header, hash, payment, session and approvals.
+func (d Deploy) Bytes() ([]byte, error) {
+	paymentBytes, err := d.Payment.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	sessionBytes, err := d.Session.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	result := d.Header.Bytes()
+	result = append(result, d.Hash.Bytes()...)
+	result = append(result, paymentBytes...)
+	result = append(result, sessionBytes...)
+	result = append(result, approvalsBytes(d.Approvals)...)
+	return result, nil
+}
+
+// DeployFromBytesDecoder decodes the Deploy from the representation produced by Bytes.
+type DeployFromBytesDecoder struct{}
+
+func (d *DeployFromBytesDecoder) FromBytes(source []byte) (*Deploy, []byte, error) {
+	header, remainder, err := (&DeployHeaderFromBytesDecoder{}).FromBytes(source)
+	if err != nil {
+		return nil, nil, decodingError("header", err)
+	}
+	hash, remainder, err := decodeHash(remainder)
+	if err != nil {
+		return nil, nil, decodingError("hash", err)
+	}
+	payment, remainder, err := (&ExecutableDeployItemFromBytesDecoder{}).FromBytes(remainder)
+	if err != nil {
+		return nil, nil, decodingError("payment", err)
+	}
+	session, remainder, err := (&ExecutableDeployItemFromBytesDecoder{}).FromBytes(remainder)
+	if err != nil {
+		return nil, nil, decodingError("session", err)
+	}
+	approvals, remainder, err := (&approvalsFromBytesDecoder{}).FromBytes(remainder)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return NewDeploy(hash, *header, *payment, *session, approvals), remainder, nil
+}
+
+// NewDeployFromBytes decodes the Deploy and rejects the bytes left after it.
+func NewDeployFromBytes(source []byte) (*Deploy, error) {
+	deploy, remainder, err := (&DeployFromBytesDecoder{}).FromBytes(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(remainder) != 0 {
+		return nil, fmt.Errorf("%w, %d bytes after the deploy", ErrTrailingBytes, len(remainder))
+	}
+	return deploy, nil
+}
+
 func (d *Deploy) Validate() error {
 	paymentBytes, err := d.Payment.Bytes()
 	if err != nil {
@@ -93,22 +302,21 @@ func (d *Deploy) Validate() error {
 		return ErrInvalidDeployHash
 	}
 
-	for _, one := range d.Approvals {
-		if one.Signer.VerifySignature(d.Hash.Bytes(), one.Signature) != nil {
-			return ErrInvalidApprovalSignature
-		}
-	}
-
-	return nil
+	return verifyApprovals(d.Hash, d.Approvals)
 }
 
 func (d *Deploy) Sign(keys keypair.PrivateKey) error {
-	signature, err := keys.Sign(d.Hash.Bytes())
+	return d.SignWith(context.Background(), keys)
+}
+
+// SignWith adds the approval created by the Signer, e.g. the key stored in an HSM or a remote service.
+func (d *Deploy) SignWith(ctx context.Context, signer keypair.Signer) error {
+	signature, err := signer.SignContext(ctx, d.Hash.Bytes())
 	if err != nil {
 		return err
 	}
 	approval := Approval{
-		Signer:    keys.PublicKey(),
+		Signer:    signer.PublicKey(),
 		Signature: signature,
 	}
 
@@ -0,0 +1,451 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+var ErrMissingDeployAccount = errors.New("deploy account is not set")
+
+// Entry points of the auction contract called by the AuctionDeployBuilder.
+const (
+	AuctionEntryPointAddBid      = "add_bid"
+	AuctionEntryPointWithdrawBid = "withdraw_bid"
+	AuctionEntryPointDelegate    = "delegate"
+	AuctionEntryPointUndelegate  = "undelegate"
+	AuctionEntryPointRedelegate  = "redelegate"
+)
+
+// DeployBuilder keeps the header and the payment shared by all Deploy builders, the payment is built with StandardPayment.
+// It is embedded into the specific builders, its setters return the embedding builder to keep the calls chained.
+type DeployBuilder[T any] struct {
+	self          T
+	header        DeployHeader
+	paymentAmount *big.Int
+}
+
+func newDeployBuilder[T any](self T) DeployBuilder[T] {
+	return DeployBuilder[T]{
+		self:   self,
+		header: DefaultDeployHeader(),
+	}
+}
+
+// WithChainName sets the name of the chain the deploy is executed on, it is required.
+func (b *DeployBuilder[T]) WithChainName(chainName string) T {
+	b.header.ChainName = chainName
+	return b.self
+}
+
+// WithAccount sets the public key of the account sending the deploy, it is required.
+func (b *DeployBuilder[T]) WithAccount(account keypair.PublicKey) T {
+	b.header.Account = account
+	return b.self
+}
+
+// WithTTL sets the duration during which the deploy is valid.
+func (b *DeployBuilder[T]) WithTTL(ttl time.Duration) T {
+	b.header.TTL = Duration(ttl)
+	return b.self
+}
+
+// WithTimestamp sets the creation time of the deploy, the builder creation time by default.
+func (b *DeployBuilder[T]) WithTimestamp(timestamp time.Time) T {
+	b.header.Timestamp = Timestamp(timestamp)
+	return b.self
+}
+
+// WithGasPrice sets the gas price of the deploy.
+func (b *DeployBuilder[T]) WithGasPrice(gasPrice uint64) T {
+	b.header.GasPrice = gasPrice
+	return b.self
+}
+
+// WithDependencies sets the deploys that should be executed before this one.
+func (b *DeployBuilder[T]) WithDependencies(dependencies ...key.Hash) T {
+	b.header.Dependencies = dependencies
+	return b.self
+}
+
+// WithPaymentAmount sets the amount of motes paid for the deploy execution, it is required.
+func (b *DeployBuilder[T]) WithPaymentAmount(amount *big.Int) T {
+	b.paymentAmount = amount
+	return b.self
+}
+
+func (b *DeployBuilder[T]) build(session ExecutableDeployItem) (*Deploy, error) {
+	if b.header.ChainName == "" {
+		return nil, ErrMissingChainName
+	}
+	if len(b.header.Account.Bytes()) == 0 {
+		return nil, ErrMissingDeployAccount
+	}
+	if err := requireAmountArgument("payment amount", b.paymentAmount); err != nil {
+		return nil, err
+	}
+	return MakeDeploy(b.header, StandardPayment(b.paymentAmount), session)
+}
+
+// TransferDeployBuilder builds the Deploy transferring motes with the TransferDeployItem.
+type TransferDeployBuilder struct {
+	DeployBuilder[*TransferDeployBuilder]
+	source *key.URef
+	target *clvalue.CLValue
+	amount *big.Int
+	id     *uint64
+}
+
+func NewTransferDeployBuilder() *TransferDeployBuilder {
+	builder := &TransferDeployBuilder{}
+	builder.DeployBuilder = newDeployBuilder(builder)
+	return builder
+}
+
+// WithSource sets the purse the motes are transferred from, the main purse of the account is used by default.
+func (b *TransferDeployBuilder) WithSource(source key.URef) *TransferDeployBuilder {
+	b.source = &source
+	return b
+}
+
+// WithTargetPublicKey sets the account receiving the motes by its public key.
+func (b *TransferDeployBuilder) WithTargetPublicKey(target keypair.PublicKey) *TransferDeployBuilder {
+	value := clvalue.NewCLPublicKey(target)
+	b.target = &value
+	return b
+}
+
+// WithTargetAccountHash sets the account receiving the motes by its account hash.
+func (b *TransferDeployBuilder) WithTargetAccountHash(target key.AccountHash) *TransferDeployBuilder {
+	value := clvalue.NewCLByteArray(target.Bytes())
+	b.target = &value
+	return b
+}
+
+// WithTargetPurse sets the purse receiving the motes.
+func (b *TransferDeployBuilder) WithTargetPurse(target key.URef) *TransferDeployBuilder {
+	value := clvalue.NewCLUref(target)
+	b.target = &value
+	return b
+}
+
+// WithAmount sets the amount of motes to transfer.
+func (b *TransferDeployBuilder) WithAmount(amount *big.Int) *TransferDeployBuilder {
+	b.amount = amount
+	return b
+}
+
+// WithID sets the user-defined identifier of the transfer, the empty option is sent by default.
+func (b *TransferDeployBuilder) WithID(id uint64) *TransferDeployBuilder {
+	b.id = &id
+	return b
+}
+
+func (b *TransferDeployBuilder) Build() (*Deploy, error) {
+	if b.target == nil {
+		return nil, fmt.Errorf("%w, argument: %s", ErrMissingTransactionArgument, NativeArgTarget)
+	}
+	if err := requireAmountArgument(NativeArgAmount, b.amount); err != nil {
+		return nil, err
+	}
+
+	idType := cltype.NewOptionType(cltype.UInt64)
+	id := clvalue.CLValue{Type: idType, Option: &clvalue.Option{Type: idType}}
+	if b.id != nil {
+		id.Option.Inner = clvalue.NewCLUInt64(*b.id)
+	}
+
+	args := Args{}
+	if b.source != nil {
+		args.AddArgument(NativeArgSource, clvalue.NewCLUref(*b.source))
+	}
+	args.AddArgument(NativeArgTarget, *b.target).
+		AddArgument(NativeArgAmount, *clvalue.NewCLUInt512(b.amount)).
+		AddArgument(NativeArgID, id)
+	return b.build(ExecutableDeployItem{Transfer: &TransferDeployItem{Args: args}})
+}
+
+// AuctionDeployBuilder builds the Deploy calling the entry point of the auction contract by its hash.
+// The auction contract hash differs between the networks and should be provided by the caller.
+type AuctionDeployBuilder struct {
+	DeployBuilder[*AuctionDeployBuilder]
+	auctionContract key.ContractHash
+	entryPoint      string
+	publicKey       *keypair.PublicKey
+	delegationRate  *uint8
+	delegationArgs
+	newValidator *keypair.PublicKey
+}
+
+func newAuctionDeployBuilder(auctionContract key.ContractHash, entryPoint string) *AuctionDeployBuilder {
+	builder := &AuctionDeployBuilder{auctionContract: auctionContract, entryPoint: entryPoint}
+	builder.DeployBuilder = newDeployBuilder(builder)
+	return builder
+}
+
+// NewAddBidDeployBuilder creates the builder of the `add_bid` call, it requires the public key, delegation rate and amount.
+func NewAddBidDeployBuilder(auctionContract key.ContractHash) *AuctionDeployBuilder {
+	return newAuctionDeployBuilder(auctionContract, AuctionEntryPointAddBid)
+}
+
+// NewWithdrawBidDeployBuilder creates the builder of the `withdraw_bid` call, it requires the public key and amount.
+func NewWithdrawBidDeployBuilder(auctionContract key.ContractHash) *AuctionDeployBuilder {
+	return newAuctionDeployBuilder(auctionContract, AuctionEntryPointWithdrawBid)
+}
+
+// NewDelegateDeployBuilder creates the builder of the `delegate` call, it requires the delegator, validator and amount.
+func NewDelegateDeployBuilder(auctionContract key.ContractHash) *AuctionDeployBuilder {
+	return newAuctionDeployBuilder(auctionContract, AuctionEntryPointDelegate)
+}
+
+// NewUndelegateDeployBuilder creates the builder of the `undelegate` call, it requires the delegator, validator and amount.
+func NewUndelegateDeployBuilder(auctionContract key.ContractHash) *AuctionDeployBuilder {
+	return newAuctionDeployBuilder(auctionContract, AuctionEntryPointUndelegate)
+}
+
+// NewRedelegateDeployBuilder creates the builder of the `redelegate` call, it requires the delegator, validator, new validator and amount.
+func NewRedelegateDeployBuilder(auctionContract key.ContractHash) *AuctionDeployBuilder {
+	return newAuctionDeployBuilder(auctionContract, AuctionEntryPointRedelegate)
+}
+
+// WithPublicKey sets the public key of the validator for the bid calls.
+func (b *AuctionDeployBuilder) WithPublicKey(publicKey keypair.PublicKey) *AuctionDeployBuilder {
+	b.publicKey = &publicKey
+	return b
+}
+
+// WithDelegationRate sets the delegation rate in percents for the `add_bid` call.
+func (b *AuctionDeployBuilder) WithDelegationRate(delegationRate uint8) *AuctionDeployBuilder {
+	b.delegationRate = &delegationRate
+	return b
+}
+
+// WithDelegator sets the public key of the delegator for the delegation calls.
+func (b *AuctionDeployBuilder) WithDelegator(delegator keypair.PublicKey) *AuctionDeployBuilder {
+	b.delegator = &delegator
+	return b
+}
+
+// WithValidator sets the public key of the validator for the delegation calls.
+func (b *AuctionDeployBuilder) WithValidator(validator keypair.PublicKey) *AuctionDeployBuilder {
+	b.validator = &validator
+	return b
+}
+
+// WithNewValidator sets the public key of the validator the stake is moved to by the `redelegate` call.
+func (b *AuctionDeployBuilder) WithNewValidator(newValidator keypair.PublicKey) *AuctionDeployBuilder {
+	b.newValidator = &newValidator
+	return b
+}
+
+// WithAmount sets the amount of motes of the call.
+func (b *AuctionDeployBuilder) WithAmount(amount *big.Int) *AuctionDeployBuilder {
+	b.amount = amount
+	return b
+}
+
+func (b *AuctionDeployBuilder) Build() (*Deploy, error) {
+	args, err := b.auctionArgs()
+	if err != nil {
+		return nil, err
+	}
+	return b.build(ExecutableDeployItem{
+		StoredContractByHash: &StoredContractByHash{
+			Hash:       b.auctionContract,
+			EntryPoint: b.entryPoint,
+			Args:       args,
+		},
+	})
+}
+
+func (b *AuctionDeployBuilder) auctionArgs() (*Args, error) {
+	switch b.entryPoint {
+	case AuctionEntryPointAddBid, AuctionEntryPointWithdrawBid:
+		if err := requirePublicKeyArgument(NativeArgPublicKey, b.publicKey); err != nil {
+			return nil, err
+		}
+		if err := requireAmountArgument(NativeArgAmount, b.amount); err != nil {
+			return nil, err
+		}
+		args := &Args{}
+		args.AddArgument(NativeArgPublicKey, clvalue.NewCLPublicKey(*b.publicKey))
+		if b.entryPoint == AuctionEntryPointAddBid {
+			if b.delegationRate == nil {
+				return nil, fmt.Errorf("%w, argument: %s", ErrMissingTransactionArgument, NativeArgDelegationRate)
+			}
+			if *b.delegationRate > MaxDelegationRate {
+				return nil, fmt.Errorf("%w, argument: %s, details: the rate should not exceed %d", ErrInvalidTransactionArgument, NativeArgDelegationRate, MaxDelegationRate)
+			}
+			args.AddArgument(NativeArgDelegationRate, *clvalue.NewCLUint8(*b.delegationRate))
+		}
+		return args.AddArgument(NativeArgAmount, *clvalue.NewCLUInt512(b.amount)), nil
+	case AuctionEntryPointDelegate, AuctionEntryPointUndelegate:
+		return b.delegationArgs.args()
+	case AuctionEntryPointRedelegate:
+		args, err := b.delegationArgs.args()
+		if err != nil {
+			return nil, err
+		}
+		if err = requirePublicKeyArgument(NativeArgNewValidator, b.newValidator); err != nil {
+			return nil, err
+		}
+		return args.AddArgument(NativeArgNewValidator, clvalue.NewCLPublicKey(*b.newValidator)), nil
+	default:
+		return nil, fmt.Errorf("%w, details: unknown auction entry point %s", ErrInvalidTransactionArgument, b.entryPoint)
+	}
+}
+
+// ContractCallDeployBuilder builds the Deploy calling the entry point of a stored contract.
+type ContractCallDeployBuilder struct {
+	DeployBuilder[*ContractCallDeployBuilder]
+	session    *ExecutableDeployItem
+	entryPoint string
+	args       *Args
+}
+
+func NewContractCallDeployBuilder() *ContractCallDeployBuilder {
+	builder := &ContractCallDeployBuilder{}
+	builder.DeployBuilder = newDeployBuilder(builder)
+	return builder
+}
+
+// WithContractHash targets the contract by its hash.
+func (b *ContractCallDeployBuilder) WithContractHash(hash key.ContractHash) *ContractCallDeployBuilder {
+	b.session = &ExecutableDeployItem{StoredContractByHash: &StoredContractByHash{Hash: hash}}
+	return b
+}
+
+// WithContractName targets the contract by the named key of the account.
+func (b *ContractCallDeployBuilder) WithContractName(name string) *ContractCallDeployBuilder {
+	b.session = &ExecutableDeployItem{StoredContractByName: &StoredContractByName{Name: name}}
+	return b
+}
+
+// WithPackageHash targets the contract package by its hash, the latest version is used if the version is nil.
+func (b *ContractCallDeployBuilder) WithPackageHash(hash key.ContractHash, version *uint32) *ContractCallDeployBuilder {
+	b.session = &ExecutableDeployItem{
+		StoredVersionedContractByHash: &StoredVersionedContractByHash{Hash: hash, Version: versionNumber(version)},
+	}
+	return b
+}
+
+// WithPackageName targets the contract package by the named key of the account, the latest version is used if the version is nil.
+func (b *ContractCallDeployBuilder) WithPackageName(name string, version *uint32) *ContractCallDeployBuilder {
+	b.session = &ExecutableDeployItem{
+		StoredVersionedContractByName: &StoredVersionedContractByName{Name: name, Version: versionNumber(version)},
+	}
+	return b
+}
+
+// WithEntryPoint sets the name of the called entry point.
+func (b *ContractCallDeployBuilder) WithEntryPoint(entryPoint string) *ContractCallDeployBuilder {
+	b.entryPoint = entryPoint
+	return b
+}
+
+// WithArgs sets the arguments passed to the entry point.
+func (b *ContractCallDeployBuilder) WithArgs(args *Args) *ContractCallDeployBuilder {
+	b.args = args
+	return b
+}
+
+func (b *ContractCallDeployBuilder) Build() (*Deploy, error) {
+	if b.session == nil {
+		return nil, fmt.Errorf("%w, details: contract is not set", ErrMissingTransactionArgument)
+	}
+	if b.entryPoint == "" {
+		return nil, fmt.Errorf("%w, details: entry point is not set", ErrMissingTransactionArgument)
+	}
+
+	session := *b.session
+	args := argsOrEmpty(b.args)
+	switch {
+	case session.StoredContractByHash != nil:
+		item := *session.StoredContractByHash
+		item.EntryPoint, item.Args = b.entryPoint, args
+		session.StoredContractByHash = &item
+	case session.StoredContractByName != nil:
+		item := *session.StoredContractByName
+		item.EntryPoint, item.Args = b.entryPoint, args
+		session.StoredContractByName = &item
+	case session.StoredVersionedContractByHash != nil:
+		item := *session.StoredVersionedContractByHash
+		item.EntryPoint, item.Args = b.entryPoint, args
+		session.StoredVersionedContractByHash = &item
+	case session.StoredVersionedContractByName != nil:
+		item := *session.StoredVersionedContractByName
+		item.EntryPoint, item.Args = b.entryPoint, args
+		session.StoredVersionedContractByName = &item
+	}
+	return b.build(session)
+}
+
+func versionNumber(version *uint32) *json.Number {
+	if version == nil {
+		return nil
+	}
+	number := json.Number(strconv.FormatUint(uint64(*version), 10))
+	return &number
+}
+
+// SessionDeployBuilder builds the Deploy executing the Wasm module, e.g. installing a contract.
+type SessionDeployBuilder struct {
+	DeployBuilder[*SessionDeployBuilder]
+	moduleBytes []byte
+	modulePath  string
+	args        *Args
+}
+
+func NewSessionDeployBuilder() *SessionDeployBuilder {
+	builder := &SessionDeployBuilder{}
+	builder.DeployBuilder = newDeployBuilder(builder)
+	return builder
+}
+
+// WithModuleBytes sets the compiled Wasm executed by the deploy.
+func (b *SessionDeployBuilder) WithModuleBytes(moduleBytes []byte) *SessionDeployBuilder {
+	b.moduleBytes, b.modulePath = moduleBytes, ""
+	return b
+}
+
+// WithModuleFile sets the path to the compiled Wasm executed by the deploy, the file is read on Build.
+func (b *SessionDeployBuilder) WithModuleFile(path string) *SessionDeployBuilder {
+	b.moduleBytes, b.modulePath = nil, path
+	return b
+}
+
+// WithArgs sets the arguments passed to the session.
+func (b *SessionDeployBuilder) WithArgs(args *Args) *SessionDeployBuilder {
+	b.args = args
+	return b
+}
+
+func (b *SessionDeployBuilder) Build() (*Deploy, error) {
+	moduleBytes := b.moduleBytes
+	if b.modulePath != "" {
+		var err error
+		if moduleBytes, err = os.ReadFile(b.modulePath); err != nil {
+			return nil, err
+		}
+	}
+	if len(moduleBytes) == 0 {
+		return nil, fmt.Errorf("%w, details: module bytes are not set", ErrMissingTransactionArgument)
+	}
+
+	return b.build(ExecutableDeployItem{
+		ModuleBytes: &ModuleBytes{
+			ModuleBytes: hex.EncodeToString(moduleBytes),
+			Args:        argsOrEmpty(b.args),
+		},
+	})
+}
@@ -79,6 +79,11 @@ func (t *SeigniorageAllocation) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+const (
+	DelegatorKindPublicKeyTag byte = iota
+	DelegatorKindPurseTag
+)
+
 // DelegatorKind Auction bid variants. Kinds of delegation bids.
 type DelegatorKind struct {
 	// Delegation from public key.
@@ -97,6 +102,17 @@ func (t *DelegatorKind) ToHex() string {
 	return ""
 }
 
+// Bytes returns the binary representation of the DelegatorKind, the purse is encoded by its address.
+func (t DelegatorKind) Bytes() ([]byte, error) {
+	switch {
+	case t.PublicKey != nil:
+		return append([]byte{DelegatorKindPublicKeyTag}, t.PublicKey.Bytes()...), nil
+	case t.Purse != nil:
+		return append([]byte{DelegatorKindPurseTag}, t.Purse.DataBytes()...), nil
+	}
+	return nil, errors.New("unexpected DelegatorKind format")
+}
+
 func (t *DelegatorKind) UnmarshalJSON(data []byte) error {
 	if t == nil {
 		return errors.New("json.RawMessage: UnmarshalJSON on nil pointer")
@@ -3,11 +3,14 @@ package types
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"strconv"
 
 	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
 	"github.com/make-software/casper-go-sdk/v2/types/key"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization/encoding"
 )
 
 type ExecutableDeployItemType byte
@@ -226,3 +229,150 @@ func StandardPayment(amount *big.Int) ExecutableDeployItem {
 		},
 	}
 }
+
+// ExecutableDeployItemFromBytesDecoder decodes the ExecutableDeployItem from the representation produced by Bytes.
+type ExecutableDeployItemFromBytesDecoder struct{}
+
+func (d *ExecutableDeployItemFromBytesDecoder) FromBytes(source []byte) (*ExecutableDeployItem, []byte, error) {
+	tag, remainder, err := (&encoding.U8FromBytesDecoder{}).FromBytes(source)
+	if err != nil {
+		return nil, nil, decodingError("tag", err)
+	}
+
+	var item ExecutableDeployItem
+	switch ExecutableDeployItemType(tag) {
+	case ExecutableDeployItemTypeModuleBytes:
+		var module []byte
+		module, remainder, err = (&encoding.BytesFromBytesDecoder{}).FromBytes(remainder)
+		if err != nil {
+			return nil, nil, decodingError("ModuleBytes.module_bytes", err)
+		}
+		args, rest, err := decodeArgs("ModuleBytes", remainder)
+		if err != nil {
+			return nil, nil, err
+		}
+		item.ModuleBytes = &ModuleBytes{ModuleBytes: hex.EncodeToString(module), Args: args}
+		remainder = rest
+	case ExecutableDeployItemTypeStoredContractByHash:
+		var hash key.Hash
+		hash, remainder, err = decodeHash(remainder)
+		if err != nil {
+			return nil, nil, decodingError("StoredContractByHash.hash", err)
+		}
+		entryPoint, args, rest, err := decodeEntryPointAndArgs("StoredContractByHash", remainder)
+		if err != nil {
+			return nil, nil, err
+		}
+		item.StoredContractByHash = &StoredContractByHash{Hash: key.ContractHash{Hash: hash}, EntryPoint: entryPoint, Args: args}
+		remainder = rest
+	case ExecutableDeployItemTypeStoredContractByName:
+		var name string
+		name, remainder, err = (&encoding.StringFromBytesDecoder{}).FromBytes(remainder)
+		if err != nil {
+			return nil, nil, decodingError("StoredContractByName.name", err)
+		}
+		entryPoint, args, rest, err := decodeEntryPointAndArgs("StoredContractByName", remainder)
+		if err != nil {
+			return nil, nil, err
+		}
+		item.StoredContractByName = &StoredContractByName{Name: name, EntryPoint: entryPoint, Args: args}
+		remainder = rest
+	case ExecutableDeployItemTypeStoredVersionedContractByHash:
+		var hash key.Hash
+		hash, remainder, err = decodeHash(remainder)
+		if err != nil {
+			return nil, nil, decodingError("StoredVersionedContractByHash.hash", err)
+		}
+		var version *json.Number
+		version, remainder, err = decodeContractVersion(remainder)
+		if err != nil {
+			return nil, nil, decodingError("StoredVersionedContractByHash.version", err)
+		}
+		entryPoint, args, rest, err := decodeEntryPointAndArgs("StoredVersionedContractByHash", remainder)
+		if err != nil {
+			return nil, nil, err
+		}
+		item.StoredVersionedContractByHash = &StoredVersionedContractByHash{
+			Hash:       key.ContractHash{Hash: hash},
+			EntryPoint: entryPoint,
+			Version:    version,
+			Args:       args,
+		}
+		remainder = rest
+	case ExecutableDeployItemTypeStoredVersionedContractByName:
+		var name string
+		name, remainder, err = (&encoding.StringFromBytesDecoder{}).FromBytes(remainder)
+		if err != nil {
+			return nil, nil, decodingError("StoredVersionedContractByName.name", err)
+		}
+		var version *json.Number
+		version, remainder, err = decodeContractVersion(remainder)
+		if err != nil {
+			return nil, nil, decodingError("StoredVersionedContractByName.version", err)
+		}
+		entryPoint, args, rest, err := decodeEntryPointAndArgs("StoredVersionedContractByName", remainder)
+		if err != nil {
+			return nil, nil, err
+		}
+		item.StoredVersionedContractByName = &StoredVersionedContractByName{
+			Name:       name,
+			EntryPoint: entryPoint,
+			Version:    version,
+			Args:       args,
+		}
+		remainder = rest
+	case ExecutableDeployItemTypeTransfer:
+		args, rest, err := decodeArgs("Transfer", remainder)
+		if err != nil {
+			return nil, nil, err
+		}
+		item.Transfer = &TransferDeployItem{Args: *args}
+		remainder = rest
+	default:
+		return nil, nil, decodingError("tag", fmt.Errorf("%w, unknown variant %d", serialization.ErrFormatting, tag))
+	}
+	return &item, remainder, nil
+}
+
+func decodeArgs(variant string, source []byte) (*Args, []byte, error) {
+	args, remainder, err := (&ArgsFromBytesDecoder{}).FromBytes(source)
+	if err != nil {
+		return nil, nil, decodingError(variant+".args", err)
+	}
+	return &args, remainder, nil
+}
+
+func decodeEntryPointAndArgs(variant string, source []byte) (string, *Args, []byte, error) {
+	entryPoint, remainder, err := (&encoding.StringFromBytesDecoder{}).FromBytes(source)
+	if err != nil {
+		return "", nil, nil, decodingError(variant+".entry_point", err)
+	}
+	args, remainder, err := decodeArgs(variant, remainder)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return entryPoint, args, remainder, nil
+}
+
+func decodeContractVersion(source []byte) (*json.Number, []byte, error) {
+	version, remainder, err := (&encoding.OptionFromBytesDecoder[uint32, *encoding.U32FromBytesDecoder]{
+		Decoder: encoding.NewU32FromBytesDecoder(),
+	}).FromBytes(source)
+	if err != nil {
+		return nil, nil, err
+	}
+	if version.Some == nil {
+		return nil, remainder, nil
+	}
+	number := json.Number(strconv.FormatUint(uint64(*version.Some), 10))
+	return &number, remainder, nil
+}
+
+func decodeHash(source []byte) (key.Hash, []byte, error) {
+	if len(source) < key.ByteHashLen {
+		return key.Hash{}, nil, serialization.ErrEarlyEndOfStream
+	}
+	var hash key.Hash
+	copy(hash[:], source[:key.ByteHashLen])
+	return hash, source[key.ByteHashLen:], nil
+}
@@ -92,11 +92,7 @@ func (addr *InitiatorAddrFromBytesDecoder) FromBytes(bytes []byte) (*InitiatorAd
 			return nil, nil, err
 		}
 
-		decoder := encoding.SliceFromBytesDecoder[uint8, *encoding.U8FromBytesDecoder]{
-			Decoder: &encoding.U8FromBytesDecoder{},
-		}
-
-		hash, finalWindow, err := serialization.DeserializeAndMaybeNext[[]uint8](nextWindow, &decoder)
+		hash, finalWindow, err := serialization.DeserializeAndMaybeNext[key.Hash](nextWindow, &hashFromBytesDecoder{})
 		if err != nil {
 			return nil, nil, err
 		}
@@ -105,7 +101,7 @@ func (addr *InitiatorAddrFromBytesDecoder) FromBytes(bytes []byte) (*InitiatorAd
 		}
 
 		accountHash := key.AccountHash{
-			Hash: key.Hash(hash),
+			Hash: hash,
 		}
 		return &InitiatorAddr{AccountHash: &accountHash}, remainder, nil
 	default:
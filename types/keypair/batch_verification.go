@@ -0,0 +1,86 @@
+package keypair
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// BatchVerificationThreshold is the number of signatures from which VerifyBatch spreads the checks across the workers,
+// fewer signatures are verified sequentially.
+const BatchVerificationThreshold = 16
+
+// SignatureVerification is the signature of the message checked by VerifyBatch,
+// the signature includes the algorithm tag prefix as for PublicKey.VerifySignature.
+type SignatureVerification struct {
+	PublicKey PublicKey
+	Message   []byte
+	Signature []byte
+}
+
+// BatchVerificationError lists the indexes of the invalid signatures in the order of the verified batch.
+type BatchVerificationError struct {
+	Failed []int
+}
+
+func (e *BatchVerificationError) Error() string {
+	return fmt.Sprintf("%s, indexes: %v", ErrInvalidSignature.Error(), e.Failed)
+}
+
+func (e *BatchVerificationError) Unwrap() error {
+	return ErrInvalidSignature
+}
+
+// VerifyBatch verifies the signatures using all the available CPUs, see VerifyBatchWithWorkers.
+func VerifyBatch(items []SignatureVerification) error {
+	return VerifyBatchWithWorkers(items, runtime.GOMAXPROCS(0))
+}
+
+// VerifyBatchWithWorkers verifies the signatures, e.g. the finality signatures of a block, with the pool of workers.
+// The keys of both algorithms are verified one by one in parallel, so the result is the same as of PublicKey.VerifySignature.
+// The ED25519 batch equation is deliberately not used, it would need the Edwards curve arithmetic missing in the standard library.
+// The returned *BatchVerificationError reports all the invalid signatures, not just the first one.
+func VerifyBatchWithWorkers(items []SignatureVerification, workers int) error {
+	valid := make([]bool, len(items))
+	if workers < 2 || len(items) < BatchVerificationThreshold {
+		for i := range items {
+			valid[i] = items[i].PublicKey.VerifySignature(items[i].Message, items[i].Signature) == nil
+		}
+		return batchResult(valid)
+	}
+
+	if workers > len(items) {
+		workers = len(items)
+	}
+	indexes := make(chan int, len(items))
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				valid[i] = items[i].PublicKey.VerifySignature(items[i].Message, items[i].Signature) == nil
+			}
+		}()
+	}
+	wg.Wait()
+	return batchResult(valid)
+}
+
+func batchResult(valid []bool) error {
+	var failed []int
+	for i, ok := range valid {
+		if !ok {
+			failed = append(failed, i)
+		}
+	}
+	if len(failed) != 0 {
+		return &BatchVerificationError{Failed: failed}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package ed25519
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+const (
+	PemBlockPublicKey  = "PUBLIC KEY"
+	PemBlockPrivateKey = "PRIVATE KEY"
+)
+
+var ErrNotED25519Key = errors.New("key isn't an ED25519 key")
+
+// PublicKeyToDer encodes the public key as the PKIX SubjectPublicKeyInfo, the format of the casper-client public_key.pem.
+func PublicKeyToDer(pub PublicKey) ([]byte, error) {
+	return x509.MarshalPKIXPublicKey(ed25519.PublicKey(pub))
+}
+
+func PublicKeyToPem(pub PublicKey) ([]byte, error) {
+	der, err := PublicKeyToDer(pub)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: PemBlockPublicKey, Bytes: der}), nil
+}
+
+// NewPublicKeyFromDer parses the PKIX SubjectPublicKeyInfo of the ED25519 public key.
+func NewPublicKeyFromDer(der []byte) (PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	public, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, ErrNotED25519Key
+	}
+	return NewPublicKey(public)
+}
+
+func NewPublicKeyFromPem(content []byte) (PublicKey, error) {
+	block, _ := pem.Decode(content)
+	if block == nil || block.Type != PemBlockPublicKey {
+		return nil, fmt.Errorf("%s block not found", PemBlockPublicKey)
+	}
+	return NewPublicKeyFromDer(block.Bytes)
+}
+
+// PrivateKeyToDer encodes the private key as PKCS #8, the format of the casper-client secret_key.pem.
+func PrivateKeyToDer(priv ed25519.PrivateKey) ([]byte, error) {
+	return x509.MarshalPKCS8PrivateKey(priv)
+}
+
+// NewPrivateKeyFromDer parses the PKCS #8 encoded ED25519 private key.
+func NewPrivateKeyFromDer(der []byte) (PrivateKey, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	private, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return PrivateKey{}, ErrNotED25519Key
+	}
+	return PrivateKey{key: private}, nil
+}
+
+// NewPrivateKeyFromSeed creates the private key from the 32 bytes seed, the raw form of the ED25519 secret key.
+func NewPrivateKeyFromSeed(seed []byte) (PrivateKey, error) {
+	if len(seed) != ed25519.SeedSize {
+		return PrivateKey{}, fmt.Errorf("wrong seed size: expected %v bytes, got %v bytes", ed25519.SeedSize, len(seed))
+	}
+	return PrivateKey{key: ed25519.NewKeyFromSeed(seed)}, nil
+}
@@ -3,30 +3,30 @@ package ed25519
 import (
 	"crypto/ed25519"
 	"encoding/pem"
+	"errors"
 )
 
 const PemFramePrivateKeyPrefixSize = 16
 
 func PrivateKeyToPem(priv ed25519.PrivateKey) ([]byte, error) {
-	// Extract the seed (first 32 bytes of the private key)
-	seed := priv[:32]
-
-	// Create the prefix for the seed
-	prefix := make([]byte, PemFramePrivateKeyPrefixSize)
-
-	// Combine the prefix and the seed to match the original format
-	fullKey := append(prefix, seed...)
+	der, err := PrivateKeyToDer(priv)
+	if err != nil {
+		return nil, err
+	}
 
 	return pem.EncodeToMemory(
 		&pem.Block{
-			Type:  "PRIVATE KEY",
-			Bytes: fullKey,
+			Type:  PemBlockPrivateKey,
+			Bytes: der,
 		},
 	), nil
 }
 
 func NewPrivateKeyFromPEM(content []byte) (PrivateKey, error) {
 	block, _ := pem.Decode(content)
+	if block == nil || len(block.Bytes) < PemFramePrivateKeyPrefixSize+ed25519.SeedSize {
+		return PrivateKey{}, errors.New("key not found")
+	}
 	// Trim PEM prefix
 	data := block.Bytes[PemFramePrivateKeyPrefixSize:]
 	// Use only last 32 bytes of private Key
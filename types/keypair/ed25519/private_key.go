@@ -16,10 +16,22 @@ func (k PrivateKey) Sign(mes []byte) ([]byte, error) {
 	return ed25519.Sign(k.key, mes), nil
 }
 
+// Seed returns the 32 bytes raw form of the private key.
+func (k PrivateKey) Seed() []byte {
+	return k.key.Seed()
+}
+
 func (k PrivateKey) ToPem() ([]byte, error) {
 	return PrivateKeyToPem(k.key)
 }
 
+// Zero overwrites the key bytes, the key can't sign afterwards.
+func (k PrivateKey) Zero() {
+	for i := range k.key {
+		k.key[i] = 0
+	}
+}
+
 func GeneratePrivateKey() (PrivateKey, error) {
 	_, priv, err := ed25519.GenerateKey(nil)
 	if err != nil {
@@ -0,0 +1,218 @@
+package keypair
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair/ed25519"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair/secp256k1"
+)
+
+const (
+	// CasperCoinType is the SLIP-44 coin type registered for Casper.
+	CasperCoinType uint32 = 506
+	// HardenedKeyStart is the first index of the hardened child keys, written with the ' suffix in the derivation path.
+	HardenedKeyStart uint32 = 0x80000000
+
+	bip44Purpose uint32 = 44
+)
+
+var (
+	ErrInvalidSeed            = errors.New("seed must be between 16 and 64 bytes")
+	ErrInvalidDerivationPath  = errors.New("invalid derivation path")
+	ErrHardenedDerivationOnly = errors.New("ED25519 keys support hardened derivation only")
+	ErrInvalidChildKey        = errors.New("derived key is invalid, use the next index")
+)
+
+// hdMasterKeys are the HMAC keys of the master key derivation, BIP-32 for SECP256K1 and SLIP-10 for ED25519.
+var hdMasterKeys = map[keyAlgorithm][]byte{
+	ED25519:   []byte("ed25519 seed"),
+	SECP256K1: []byte("Bitcoin seed"),
+}
+
+// DerivationPath is the list of child indexes from the master key, e.g. m/44'/506'/0'/0/0.
+type DerivationPath []uint32
+
+// ParseDerivationPath parses the path in the BIP-32 notation, the hardened indexes are marked with ' or h.
+func ParseDerivationPath(path string) (DerivationPath, error) {
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if segments[0] != "m" {
+		return nil, fmt.Errorf("%w, path must start with m: %s", ErrInvalidDerivationPath, path)
+	}
+
+	result := make(DerivationPath, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		offset := uint32(0)
+		if trimmed := strings.TrimRight(segment, "'hH"); trimmed != segment {
+			if len(segment)-len(trimmed) != 1 {
+				return nil, fmt.Errorf("%w, segment: %s", ErrInvalidDerivationPath, segment)
+			}
+			segment, offset = trimmed, HardenedKeyStart
+		}
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil || uint32(index) >= HardenedKeyStart {
+			return nil, fmt.Errorf("%w, segment: %s", ErrInvalidDerivationPath, segment)
+		}
+		result = append(result, uint32(index)+offset)
+	}
+	return result, nil
+}
+
+// CasperDerivationPath returns the BIP-44 path of the key used by the Casper wallets:
+// m/44'/506'/account'/0/index for SECP256K1 and m/44'/506'/account'/0'/index' for ED25519,
+// where all the indexes have to be hardened.
+func CasperDerivationPath(algorithm KeyAlgorithm, account, index uint32) DerivationPath {
+	if algorithm == ED25519 {
+		return DerivationPath{
+			bip44Purpose + HardenedKeyStart,
+			CasperCoinType + HardenedKeyStart,
+			account + HardenedKeyStart,
+			HardenedKeyStart,
+			index + HardenedKeyStart,
+		}
+	}
+	return DerivationPath{bip44Purpose + HardenedKeyStart, CasperCoinType + HardenedKeyStart, account + HardenedKeyStart, 0, index}
+}
+
+func (p DerivationPath) String() string {
+	var builder strings.Builder
+	builder.WriteString("m")
+	for _, index := range p {
+		if index >= HardenedKeyStart {
+			builder.WriteString(fmt.Sprintf("/%d'", index-HardenedKeyStart))
+		} else {
+			builder.WriteString(fmt.Sprintf("/%d", index))
+		}
+	}
+	return builder.String()
+}
+
+// ExtendedKey is the private key of the hierarchical deterministic wallet together with its chain code.
+type ExtendedKey struct {
+	alg       keyAlgorithm
+	Key       []byte
+	ChainCode []byte
+}
+
+// NewMasterKey derives the master key of the wallet from the seed,
+// following BIP-32 for SECP256K1 and SLIP-10 for ED25519.
+func NewMasterKey(seed []byte, algorithm KeyAlgorithm) (*ExtendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, ErrInvalidSeed
+	}
+	hmacKey, ok := hdMasterKeys[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("unsupported key algorithm: %v", algorithm)
+	}
+	mac := hmac.New(sha512.New, hmacKey)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	if algorithm == SECP256K1 {
+		var scalar dcrsecp256k1.ModNScalar
+		if overflow := scalar.SetByteSlice(sum[:32]); overflow || scalar.IsZero() {
+			return nil, ErrInvalidChildKey
+		}
+	}
+	return &ExtendedKey{alg: algorithm, Key: sum[:32], ChainCode: sum[32:]}, nil
+}
+
+// NewPrivateKeyFromSeed derives the private key of the algorithm at the path, e.g. m/44'/506'/0'/0/0.
+func NewPrivateKeyFromSeed(seed []byte, path string, algorithm KeyAlgorithm) (PrivateKey, error) {
+	derivationPath, err := ParseDerivationPath(path)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	master, err := NewMasterKey(seed, algorithm)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	extended, err := master.Derive(derivationPath)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	return extended.PrivateKey()
+}
+
+// NewPrivateKeyFromMnemonic derives the private key at the path from the BIP-39 mnemonic and the optional passphrase.
+func NewPrivateKeyFromMnemonic(mnemonic, passphrase, path string, algorithm KeyAlgorithm) (PrivateKey, error) {
+	seed, err := NewSeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	defer zero(seed)
+	return NewPrivateKeyFromSeed(seed, path, algorithm)
+}
+
+// Derive returns the descendant key at the path relative to the key.
+func (k *ExtendedKey) Derive(path DerivationPath) (*ExtendedKey, error) {
+	result := k
+	for _, index := range path {
+		child, err := result.Child(index)
+		if err != nil {
+			return nil, fmt.Errorf("%w, path: %s", err, path)
+		}
+		result = child
+	}
+	return result, nil
+}
+
+// Child derives the direct child key at the index, the indexes from HardenedKeyStart derive the hardened keys.
+func (k *ExtendedKey) Child(index uint32) (*ExtendedKey, error) {
+	data := make([]byte, 0, 37)
+	if index >= HardenedKeyStart {
+		data = append(append(data, 0), k.Key...)
+	} else {
+		if k.alg == ED25519 {
+			return nil, ErrHardenedDerivationOnly
+		}
+		data = append(data, dcrsecp256k1.PrivKeyFromBytes(k.Key).PubKey().SerializeCompressed()...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, k.ChainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	zero(data)
+
+	if k.alg == ED25519 {
+		return &ExtendedKey{alg: k.alg, Key: sum[:32], ChainCode: sum[32:]}, nil
+	}
+
+	var tweak, parent dcrsecp256k1.ModNScalar
+	if overflow := tweak.SetByteSlice(sum[:32]); overflow {
+		return nil, ErrInvalidChildKey
+	}
+	parent.SetByteSlice(k.Key)
+	tweak.Add(&parent)
+	if tweak.IsZero() {
+		return nil, ErrInvalidChildKey
+	}
+	childKey := tweak.Bytes()
+	return &ExtendedKey{alg: k.alg, Key: childKey[:], ChainCode: sum[32:]}, nil
+}
+
+// PrivateKey converts the extended key into the PrivateKey of its algorithm.
+func (k *ExtendedKey) PrivateKey() (PrivateKey, error) {
+	var priv PrivateKeyInternal
+	var err error
+	switch k.alg {
+	case ED25519:
+		priv, err = ed25519.NewPrivateKeyFromSeed(k.Key)
+	case SECP256K1:
+		priv, err = secp256k1.NewPrivateKeyFromBytes(k.Key)
+	default:
+		return PrivateKey{}, fmt.Errorf("unsupported key algorithm: %v", k.alg)
+	}
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	return newPrivateKey(k.alg, priv)
+}
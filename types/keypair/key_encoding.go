@@ -0,0 +1,222 @@
+package keypair
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair/ed25519"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair/secp256k1"
+)
+
+var ErrUnknownKeyFormat = errors.New("unknown key format")
+
+// Algorithm returns the signature algorithm of the key.
+func (v PublicKey) Algorithm() KeyAlgorithm {
+	return v.cryptoAlg
+}
+
+// RawBytes returns the public key without the algorithm tag prefix,
+// 32 bytes for ED25519 and the 33 bytes compressed point for SECP256K1.
+func (v PublicKey) RawBytes() []byte {
+	if v.key == nil {
+		return nil
+	}
+	return v.key.Bytes()
+}
+
+// UncompressedBytes returns the 65 bytes uncompressed point of the SECP256K1 key, ED25519 keys have a single form returned by RawBytes.
+func (v PublicKey) UncompressedBytes() []byte {
+	switch key := v.key.(type) {
+	case secp256k1.PublicKey:
+		return key.UncompressedBytes()
+	default:
+		return v.RawBytes()
+	}
+}
+
+// ToDer encodes the public key as the PKIX SubjectPublicKeyInfo.
+func (v PublicKey) ToDer() ([]byte, error) {
+	switch key := v.key.(type) {
+	case ed25519.PublicKey:
+		return ed25519.PublicKeyToDer(key)
+	case secp256k1.PublicKey:
+		return secp256k1.PublicKeyToDer(key.Key())
+	default:
+		return nil, ErrEmptyPublicKey
+	}
+}
+
+// ToPem encodes the public key in the format of the casper-client public_key.pem.
+func (v PublicKey) ToPem() ([]byte, error) {
+	der, err := v.ToDer()
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: ed25519.PemBlockPublicKey, Bytes: der}), nil
+}
+
+// NewPublicKeyFromRaw creates the public key from the bytes without the algorithm tag, the algorithm is detected by the size:
+// 32 bytes for ED25519, the 33 bytes compressed and the 65 bytes uncompressed point for SECP256K1.
+func NewPublicKeyFromRaw(raw []byte) (PublicKey, error) {
+	switch len(raw) {
+	case ed25519.PublicKeySize:
+		key, err := ed25519.NewPublicKey(raw)
+		if err != nil {
+			return PublicKey{}, err
+		}
+		return PublicKey{cryptoAlg: ED25519, key: key}, nil
+	case secp256k1.PublicKeySize, secp256k1.UncompressedPublicKeySize:
+		key, err := secp256k1.ParsePublicKey(raw)
+		if err != nil {
+			return PublicKey{}, err
+		}
+		return PublicKey{cryptoAlg: SECP256K1, key: key}, nil
+	default:
+		return PublicKey{}, ErrInvalidPublicKeySize
+	}
+}
+
+// NewPublicKeyFromDER parses the PKIX SubjectPublicKeyInfo of the public key of either algorithm.
+func NewPublicKeyFromDER(der []byte) (PublicKey, error) {
+	if key, err := secp256k1.NewPublicKeyFromDer(der); err == nil {
+		return PublicKey{cryptoAlg: SECP256K1, key: key}, nil
+	}
+	key, err := ed25519.NewPublicKeyFromDer(der)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("%w, details: %s", ErrUnknownKeyFormat, err.Error())
+	}
+	return PublicKey{cryptoAlg: ED25519, key: key}, nil
+}
+
+// NewPublicKeyFromPEM parses the PEM public key of either algorithm, e.g. the casper-client public_key.pem.
+func NewPublicKeyFromPEM(content []byte) (PublicKey, error) {
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return PublicKey{}, fmt.Errorf("%w, details: PEM block not found", ErrUnknownKeyFormat)
+	}
+	switch block.Type {
+	case ed25519.PemBlockPublicKey:
+		return NewPublicKeyFromDER(block.Bytes)
+	case secp256k1.PemBlockLegacyPublicKey:
+		return NewPublicKeyFromRaw(block.Bytes)
+	default:
+		return PublicKey{}, fmt.Errorf("%w, details: unexpected PEM block %s", ErrUnknownKeyFormat, block.Type)
+	}
+}
+
+// ParsePublicKey detects the format and the algorithm of the public key: PEM, hex, tagged and raw bytes or DER.
+func ParsePublicKey(content []byte) (PublicKey, error) {
+	trimmed := bytes.TrimSpace(content)
+	if bytes.HasPrefix(trimmed, []byte("-----BEGIN")) {
+		return NewPublicKeyFromPEM(trimmed)
+	}
+	if decoded, err := hex.DecodeString(string(trimmed)); err == nil && len(trimmed) > 0 {
+		content = decoded
+	}
+
+	switch {
+	case len(content) == 1+ed25519.PublicKeySize && content[0] == ED25519.Byte(),
+		len(content) == 1+secp256k1.PublicKeySize && content[0] == SECP256K1.Byte():
+		return NewPublicKeyFromBytes(content)
+	case len(content) == ed25519.PublicKeySize, len(content) == secp256k1.PublicKeySize,
+		len(content) == secp256k1.UncompressedPublicKeySize:
+		return NewPublicKeyFromRaw(content)
+	default:
+		return NewPublicKeyFromDER(content)
+	}
+}
+
+// RawBytes returns the 32 bytes raw form of the private key, the seed for ED25519 and the scalar for SECP256K1.
+func (v PrivateKey) RawBytes() []byte {
+	switch key := v.priv.(type) {
+	case ed25519.PrivateKey:
+		return key.Seed()
+	case secp256k1.PrivateKey:
+		return key.Bytes()
+	default:
+		return nil
+	}
+}
+
+// ToDer encodes the private key as PKCS #8 for ED25519 and in the SEC 1 form for SECP256K1, as the casper-client does.
+func (v PrivateKey) ToDer() ([]byte, error) {
+	content, err := v.priv.ToPem()
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, ErrUnknownKeyFormat
+	}
+	return block.Bytes, nil
+}
+
+// NewPrivateKeyFromRaw creates the private key of the algorithm from its 32 bytes raw form.
+func NewPrivateKeyFromRaw(raw []byte, algorithm KeyAlgorithm) (PrivateKey, error) {
+	var priv PrivateKeyInternal
+	var err error
+	switch algorithm {
+	case ED25519:
+		priv, err = ed25519.NewPrivateKeyFromSeed(raw)
+	case SECP256K1:
+		priv, err = secp256k1.NewPrivateKeyFromBytes(raw)
+	default:
+		return PrivateKey{}, fmt.Errorf("unsupported key algorithm: %v", algorithm)
+	}
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	return newPrivateKey(algorithm, priv)
+}
+
+// NewPrivateKeyFromDER parses the private key of either algorithm encoded as PKCS #8 or in the SEC 1 form.
+func NewPrivateKeyFromDER(der []byte) (PrivateKey, error) {
+	if priv, err := ed25519.NewPrivateKeyFromDer(der); err == nil {
+		return newPrivateKey(ED25519, priv)
+	}
+	priv, err := secp256k1.NewPrivateKeyFromDer(der)
+	if err != nil {
+		return PrivateKey{}, fmt.Errorf("%w, details: %s", ErrUnknownKeyFormat, err.Error())
+	}
+	return newPrivateKey(SECP256K1, priv)
+}
+
+// ParsePrivateKey detects the algorithm of the PEM or DER encoded private key, e.g. the casper-client secret_key.pem.
+func ParsePrivateKey(content []byte) (PrivateKey, error) {
+	trimmed := bytes.TrimSpace(content)
+	if !bytes.HasPrefix(trimmed, []byte("-----BEGIN")) {
+		return NewPrivateKeyFromDER(content)
+	}
+
+	block, _ := pem.Decode(trimmed)
+	if block == nil {
+		return PrivateKey{}, fmt.Errorf("%w, details: PEM block not found", ErrUnknownKeyFormat)
+	}
+	switch block.Type {
+	case secp256k1.PemBlockPrivateKey:
+		return NewPrivateKeyFromPEM(trimmed, SECP256K1)
+	case ed25519.PemBlockPrivateKey:
+		if privateKey, err := NewPrivateKeyFromDER(block.Bytes); err == nil {
+			return privateKey, nil
+		}
+		// ED25519 keys written by the previous versions of the SDK don't have a valid PKCS #8 header
+		return NewPrivateKeyFromPEM(trimmed, ED25519)
+	default:
+		return PrivateKey{}, fmt.Errorf("%w, details: unexpected PEM block %s", ErrUnknownKeyFormat, block.Type)
+	}
+}
+
+func newPrivateKey(algorithm keyAlgorithm, priv PrivateKeyInternal) (PrivateKey, error) {
+	publicKey, err := NewPublicKeyFromBytes(append([]byte{byte(algorithm)}, priv.PublicKeyBytes()...))
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	return PrivateKey{
+		alg:  algorithm,
+		pub:  publicKey,
+		priv: priv,
+	}, nil
+}
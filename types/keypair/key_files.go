@@ -0,0 +1,88 @@
+package keypair
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Names of the files written by casper-client keygen.
+const (
+	SecretKeyFileName    = "secret_key.pem"
+	PublicKeyFileName    = "public_key.pem"
+	PublicKeyHexFileName = "public_key_hex"
+)
+
+var ErrKeyFilesMismatch = errors.New("key files contain different keys")
+
+// WriteKeyFiles stores the key in the directory as casper-client keygen does:
+// secret_key.pem readable by the owner only, public_key.pem and public_key_hex.
+func WriteKeyFiles(dir string, privateKey PrivateKey) error {
+	secretKey, err := privateKey.ToPem()
+	if err != nil {
+		return err
+	}
+	publicKey, err := privateKey.PublicKey().ToPem()
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	if err = os.WriteFile(filepath.Join(dir, SecretKeyFileName), secretKey, 0600); err != nil {
+		return err
+	}
+	if err = os.WriteFile(filepath.Join(dir, PublicKeyFileName), publicKey, 0644); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, PublicKeyHexFileName), []byte(privateKey.PublicKey().ToHex()), 0644)
+}
+
+// NewPrivateKeyFromKeyDir reads the private key from the directory created by casper-client keygen, the algorithm is detected.
+// The public key files, if present, have to match the private key.
+func NewPrivateKeyFromKeyDir(dir string) (PrivateKey, error) {
+	content, err := os.ReadFile(filepath.Join(dir, SecretKeyFileName))
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	privateKey, err := ParsePrivateKey(content)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	for _, name := range []string{PublicKeyHexFileName, PublicKeyFileName} {
+		publicKey, err := readPublicKeyFile(filepath.Join(dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return PrivateKey{}, err
+		}
+		if !publicKey.Equals(privateKey.PublicKey()) {
+			return PrivateKey{}, ErrKeyFilesMismatch
+		}
+	}
+	return privateKey, nil
+}
+
+// NewPublicKeyFromKeyDir reads the public key from public_key_hex or, if it's missing, from public_key.pem of the directory.
+func NewPublicKeyFromKeyDir(dir string) (PublicKey, error) {
+	publicKey, err := readPublicKeyFile(filepath.Join(dir, PublicKeyHexFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return readPublicKeyFile(filepath.Join(dir, PublicKeyFileName))
+	}
+	return publicKey, err
+}
+
+func readPublicKeyFile(path string) (PublicKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	if strings.HasSuffix(path, PublicKeyHexFileName) {
+		return NewPublicKey(strings.TrimSpace(string(content)))
+	}
+	return NewPublicKeyFromPEM(content)
+}
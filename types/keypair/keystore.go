@@ -0,0 +1,310 @@
+package keypair
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// KeystoreVersion is the version of the keystore format produced by this package.
+	KeystoreVersion = 1
+
+	KeystoreKDFScrypt       = "scrypt"
+	KeystoreCipherAES256GCM = "aes-256-gcm"
+
+	keystoreKeyLength  = 32
+	keystoreSaltLength = 32
+	keystoreFileExt    = ".json"
+
+	// The bounds of the scrypt cost parameters, the parameters are read from the file,
+	// so a crafted file must not make the derivation exhaust the memory or the CPU.
+	maxScryptN      = 1 << 20
+	maxScryptRP     = 32
+	maxScryptMemory = 1 << 30
+)
+
+var (
+	ErrUnsupportedKeystore     = errors.New("unsupported keystore")
+	ErrInvalidKeystorePassword = errors.New("invalid keystore password")
+	ErrKeystoreKeyMismatch     = errors.New("keystore key doesn't match the public key")
+	ErrEmptyKeystorePassword   = errors.New("keystore password is empty")
+	ErrInvalidScryptParams     = errors.New("invalid scrypt params")
+)
+
+// ScryptParams are the cost parameters of the scrypt key derivation.
+type ScryptParams struct {
+	N int `json:"n"`
+	R int `json:"r"`
+	P int `json:"p"`
+}
+
+// Validate checks that N is a power of two greater than 1 and that the parameters don't exceed the bounds:
+// N up to 2^20, r*p up to 32 and 128*N*r bytes of memory up to 1GB.
+func (p ScryptParams) Validate() error {
+	if p.N <= 1 || p.N > maxScryptN || p.N&(p.N-1) != 0 {
+		return fmt.Errorf("%w, details: N must be a power of two between 2 and %d, got %d", ErrInvalidScryptParams, maxScryptN, p.N)
+	}
+	if p.R < 1 || p.P < 1 || p.R*p.P > maxScryptRP {
+		return fmt.Errorf("%w, details: r and p must be positive with r*p up to %d, got r: %d, p: %d", ErrInvalidScryptParams, maxScryptRP, p.R, p.P)
+	}
+	if 128*p.N*p.R > maxScryptMemory {
+		return fmt.Errorf("%w, details: 128*N*r exceeds %d bytes", ErrInvalidScryptParams, maxScryptMemory)
+	}
+	return nil
+}
+
+var (
+	// DefaultScryptParams are used by NewKeystore, the derivation takes about a second and 256MB of memory.
+	DefaultScryptParams = ScryptParams{N: 1 << 18, R: 8, P: 1}
+	// LightScryptParams are suitable for the devices with limited resources and for the tests.
+	LightScryptParams = ScryptParams{N: 1 << 12, R: 8, P: 1}
+)
+
+// KeystoreKDF describes the derivation of the encryption key from the password.
+type KeystoreKDF struct {
+	Name   string       `json:"name"`
+	Params ScryptParams `json:"params"`
+	Salt   string       `json:"salt"`
+}
+
+// KeystoreCrypto holds the encrypted private key, the PEM encoded key is encrypted with the public key as additional data.
+type KeystoreCrypto struct {
+	KDF        KeystoreKDF `json:"kdf"`
+	Cipher     string      `json:"cipher"`
+	Nonce      string      `json:"nonce"`
+	Ciphertext string      `json:"ciphertext"`
+}
+
+// Keystore is the password-encrypted private key stored as a versioned JSON file.
+// The algorithm and the public key are kept in the clear to look the key up without the password.
+type Keystore struct {
+	Version   int            `json:"version"`
+	Algorithm string         `json:"algorithm"`
+	PublicKey PublicKey      `json:"public_key"`
+	Crypto    KeystoreCrypto `json:"crypto"`
+}
+
+// KeystoreFile is the Keystore found in a directory by ListKeystores.
+type KeystoreFile struct {
+	Path     string
+	Keystore *Keystore
+}
+
+// NewKeystore encrypts the private key with the password using DefaultScryptParams.
+func NewKeystore(privateKey PrivateKey, password []byte) (*Keystore, error) {
+	return NewKeystoreWithParams(privateKey, password, DefaultScryptParams)
+}
+
+// NewKeystoreWithParams encrypts the private key with the password using the given scrypt cost parameters.
+func NewKeystoreWithParams(privateKey PrivateKey, password []byte, params ScryptParams) (*Keystore, error) {
+	keystore := &Keystore{
+		Version:   KeystoreVersion,
+		Algorithm: privateKey.alg.String(),
+		PublicKey: privateKey.PublicKey(),
+	}
+	if err := keystore.encrypt(privateKey, password, params); err != nil {
+		return nil, err
+	}
+	return keystore, nil
+}
+
+// NewKeystoreFromFile reads the Keystore from the JSON file, the key stays encrypted until Unlock.
+func NewKeystoreFromFile(path string) (*Keystore, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var keystore Keystore
+	if err = json.Unmarshal(content, &keystore); err != nil {
+		return nil, fmt.Errorf("%w, details: %s", ErrUnsupportedKeystore, err.Error())
+	}
+	if err = keystore.validate(); err != nil {
+		return nil, err
+	}
+	return &keystore, nil
+}
+
+// ListKeystores returns the keystores found in the JSON files of the directory, the other files are skipped.
+func ListKeystores(dir string) ([]KeystoreFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]KeystoreFile, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), keystoreFileExt) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		keystore, err := NewKeystoreFromFile(path)
+		if err != nil {
+			continue
+		}
+		result = append(result, KeystoreFile{Path: path, Keystore: keystore})
+	}
+	return result, nil
+}
+
+// WriteFile stores the keystore as the JSON file readable by the owner only.
+func (k *Keystore) WriteFile(path string) error {
+	content, err := json.MarshalIndent(k, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0600)
+}
+
+// FileName is the default name of the keystore file, derived from the public key.
+func (k *Keystore) FileName() string {
+	return k.PublicKey.ToHex() + keystoreFileExt
+}
+
+// Unlock decrypts the private key with the password.
+func (k *Keystore) Unlock(password []byte) (PrivateKey, error) {
+	if err := k.validate(); err != nil {
+		return PrivateKey{}, err
+	}
+	pem, err := k.decrypt(password)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	defer zero(pem)
+
+	privateKey, err := NewPrivateKeyFromPEM(pem, k.PublicKey.cryptoAlg)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	if !privateKey.PublicKey().Equals(k.PublicKey) {
+		return PrivateKey{}, ErrKeystoreKeyMismatch
+	}
+	return privateKey, nil
+}
+
+// ChangePassword re-encrypts the private key with the new password, keeping the scrypt cost parameters.
+// The unlocked key is wiped with PrivateKey.Zero once it is re-encrypted.
+func (k *Keystore) ChangePassword(oldPassword, newPassword []byte) error {
+	privateKey, err := k.Unlock(oldPassword)
+	if err != nil {
+		return err
+	}
+	defer privateKey.Zero()
+	return k.encrypt(privateKey, newPassword, k.Crypto.KDF.Params)
+}
+
+func (k *Keystore) encrypt(privateKey PrivateKey, password []byte, params ScryptParams) error {
+	if len(password) == 0 {
+		return ErrEmptyKeystorePassword
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	pem, err := privateKey.ToPem()
+	if err != nil {
+		return err
+	}
+	defer zero(pem)
+
+	salt := make([]byte, keystoreSaltLength)
+	if _, err = rand.Read(salt); err != nil {
+		return err
+	}
+	aead, err := newKeystoreAEAD(password, salt, params)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return err
+	}
+
+	k.Crypto = KeystoreCrypto{
+		KDF: KeystoreKDF{
+			Name:   KeystoreKDFScrypt,
+			Params: params,
+			Salt:   hex.EncodeToString(salt),
+		},
+		Cipher:     KeystoreCipherAES256GCM,
+		Nonce:      hex.EncodeToString(nonce),
+		Ciphertext: hex.EncodeToString(aead.Seal(nil, nonce, pem, k.PublicKey.Bytes())),
+	}
+	return nil
+}
+
+func (k *Keystore) decrypt(password []byte) ([]byte, error) {
+	salt, err := hex.DecodeString(k.Crypto.KDF.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w, details: invalid salt", ErrUnsupportedKeystore)
+	}
+	nonce, err := hex.DecodeString(k.Crypto.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w, details: invalid nonce", ErrUnsupportedKeystore)
+	}
+	ciphertext, err := hex.DecodeString(k.Crypto.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w, details: invalid ciphertext", ErrUnsupportedKeystore)
+	}
+
+	aead, err := newKeystoreAEAD(password, salt, k.Crypto.KDF.Params)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w, details: invalid nonce", ErrUnsupportedKeystore)
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, k.PublicKey.Bytes())
+	if err != nil {
+		return nil, ErrInvalidKeystorePassword
+	}
+	return plaintext, nil
+}
+
+func (k *Keystore) validate() error {
+	if k.Version != KeystoreVersion {
+		return fmt.Errorf("%w, version: %d", ErrUnsupportedKeystore, k.Version)
+	}
+	if k.Crypto.KDF.Name != KeystoreKDFScrypt || k.Crypto.Cipher != KeystoreCipherAES256GCM {
+		return fmt.Errorf("%w, kdf: %s, cipher: %s", ErrUnsupportedKeystore, k.Crypto.KDF.Name, k.Crypto.Cipher)
+	}
+	if k.PublicKey.key == nil || k.PublicKey.cryptoAlg.String() != k.Algorithm {
+		return fmt.Errorf("%w, details: algorithm doesn't match the public key", ErrUnsupportedKeystore)
+	}
+	if err := k.Crypto.KDF.Params.Validate(); err != nil {
+		return fmt.Errorf("%w, %w", ErrUnsupportedKeystore, err)
+	}
+	return nil
+}
+
+func newKeystoreAEAD(password, salt []byte, params ScryptParams) (cipher.AEAD, error) {
+	if len(password) == 0 {
+		return nil, ErrEmptyKeystorePassword
+	}
+	derivedKey, err := scrypt.Key(password, salt, params.N, params.R, params.P, keystoreKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("%w, details: %s", ErrUnsupportedKeystore, err.Error())
+	}
+	defer zero(derivedKey)
+
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// zero overwrites the sensitive data once it isn't needed anymore.
+func zero(data []byte) {
+	for i := range data {
+		data[i] = 0
+	}
+}
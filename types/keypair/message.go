@@ -0,0 +1,37 @@
+package keypair
+
+import (
+	"crypto/ed25519"
+)
+
+// CasperMessagePrefix is prepended by the Casper wallets to the off-chain messages before signing,
+// so a signed message can never be mistaken for a signed deploy or transaction.
+const CasperMessagePrefix = "Casper Message:\n"
+
+// rawSignatureLength is the length of the signature without the algorithm tag, the same for both algorithms.
+const rawSignatureLength = ed25519.SignatureSize
+
+// FormatMessage returns the bytes signed for the off-chain message, the message with the CasperMessagePrefix.
+func FormatMessage(message string) []byte {
+	return []byte(CasperMessagePrefix + message)
+}
+
+// SignMessage signs the off-chain message as the Casper wallets do. The ED25519 key signs the prefixed message,
+// the SECP256K1 key signs its SHA-256 digest. The signature includes the algorithm tag prefix.
+func (v PrivateKey) SignMessage(message string) ([]byte, error) {
+	return v.Sign(FormatMessage(message))
+}
+
+// VerifyMessage verifies the signature of the off-chain message created by SignMessage or a Casper wallet.
+// The signature is accepted both with the algorithm tag prefix and without it, as returned by the wallets.
+func (v PublicKey) VerifyMessage(message string, signature []byte) error {
+	if len(signature) == 0 {
+		return ErrEmptySignature
+	}
+	if len(signature) == rawSignatureLength {
+		signature = append([]byte{v.cryptoAlg.Byte()}, signature...)
+	} else if signature[0] != v.cryptoAlg.Byte() {
+		return ErrInvalidSignature
+	}
+	return v.VerifySignature(FormatMessage(message), signature)
+}
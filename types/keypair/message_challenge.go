@@ -0,0 +1,115 @@
+package keypair
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const challengeNonceLength = 32
+
+var (
+	ErrChallengeExpired     = errors.New("challenge is expired")
+	ErrUnknownChallenge     = errors.New("challenge is unknown or already used")
+	ErrChallengeKeyMismatch = errors.New("challenge was issued for another public key")
+)
+
+// MessageChallenge is the off-chain message a backend asks the user to sign to prove the ownership of the public key.
+type MessageChallenge struct {
+	// Domain of the service requesting the signature, shown to the user.
+	Domain    string    `json:"domain"`
+	PublicKey PublicKey `json:"public_key"`
+	// Random hex encoded value making every challenge unique.
+	Nonce     string    `json:"nonce"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewMessageChallenge creates the challenge for the public key with a random nonce, valid during the ttl.
+func NewMessageChallenge(domain string, publicKey PublicKey, ttl time.Duration) (MessageChallenge, error) {
+	nonce := make([]byte, challengeNonceLength)
+	if _, err := rand.Read(nonce); err != nil {
+		return MessageChallenge{}, err
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	return MessageChallenge{
+		Domain:    domain,
+		PublicKey: publicKey,
+		Nonce:     hex.EncodeToString(nonce),
+		IssuedAt:  now,
+		ExpiresAt: now.Add(ttl),
+	}, nil
+}
+
+// Message returns the human-readable text signed by the user with PrivateKey.SignMessage or a Casper wallet.
+func (c MessageChallenge) Message() string {
+	return fmt.Sprintf("%s wants you to sign in with your Casper account:\n%s\n\nNonce: %s\nIssued At: %s\nExpiration Time: %s",
+		c.Domain, c.PublicKey.ToHex(), c.Nonce, c.IssuedAt.Format(time.RFC3339), c.ExpiresAt.Format(time.RFC3339))
+}
+
+// Verify checks that the challenge isn't expired at the given time and the signature of its Message was made by the PublicKey.
+// The caller is responsible for accepting every nonce only once, ChallengeStore does it for the challenges kept in memory.
+func (c MessageChallenge) Verify(signature []byte, now time.Time) error {
+	if !now.Before(c.ExpiresAt) {
+		return ErrChallengeExpired
+	}
+	return c.PublicKey.VerifyMessage(c.Message(), signature)
+}
+
+// ChallengeStore issues the challenges and keeps them in memory until they are answered or expire,
+// so every challenge authenticates the user at most once.
+type ChallengeStore struct {
+	domain     string
+	ttl        time.Duration
+	mu         sync.Mutex
+	challenges map[string]MessageChallenge
+}
+
+func NewChallengeStore(domain string, ttl time.Duration) *ChallengeStore {
+	return &ChallengeStore{
+		domain:     domain,
+		ttl:        ttl,
+		challenges: make(map[string]MessageChallenge),
+	}
+}
+
+// Issue creates the challenge for the public key, its Message has to be signed by the user.
+func (s *ChallengeStore) Issue(publicKey PublicKey) (MessageChallenge, error) {
+	challenge, err := NewMessageChallenge(s.domain, publicKey, s.ttl)
+	if err != nil {
+		return MessageChallenge{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeExpired(time.Now())
+	s.challenges[challenge.Nonce] = challenge
+	return challenge, nil
+}
+
+// Authenticate verifies the response to the challenge with the nonce, the challenge is consumed even if the signature is invalid.
+func (s *ChallengeStore) Authenticate(publicKey PublicKey, nonce string, signature []byte) error {
+	s.mu.Lock()
+	challenge, ok := s.challenges[nonce]
+	delete(s.challenges, nonce)
+	s.mu.Unlock()
+
+	if !ok {
+		return ErrUnknownChallenge
+	}
+	if !challenge.PublicKey.Equals(publicKey) {
+		return ErrChallengeKeyMismatch
+	}
+	return challenge.Verify(signature, time.Now())
+}
+
+func (s *ChallengeStore) removeExpired(now time.Time) {
+	for nonce, challenge := range s.challenges {
+		if !now.Before(challenge.ExpiresAt) {
+			delete(s.challenges, nonce)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package keypair
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	mnemonicSaltPrefix  = "mnemonic"
+	mnemonicIterations  = 2048
+	mnemonicSeedLength  = 64
+	mnemonicBitsPerWord = 11
+)
+
+var (
+	ErrInvalidEntropyLength    = errors.New("entropy length must be 128, 160, 192, 224 or 256 bits")
+	ErrInvalidMnemonicLength   = errors.New("mnemonic must have 12, 15, 18, 21 or 24 words")
+	ErrUnknownMnemonicWord     = errors.New("word isn't in the BIP-39 wordlist")
+	ErrInvalidMnemonicChecksum = errors.New("invalid mnemonic checksum")
+)
+
+var mnemonicWordIndex = func() map[string]int {
+	result := make(map[string]int, len(englishWordlist))
+	for i, word := range englishWordlist {
+		result[word] = i
+	}
+	return result
+}()
+
+// NewMnemonic generates a random BIP-39 mnemonic of the given number of words, 24 words hold 256 bits of entropy.
+func NewMnemonic(words int) (string, error) {
+	if words < 12 || words > 24 || words%3 != 0 {
+		return "", ErrInvalidMnemonicLength
+	}
+	entropy := make([]byte, words*mnemonicBitsPerWord*32/33/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", err
+	}
+	defer zero(entropy)
+	return NewMnemonicFromEntropy(entropy)
+}
+
+// NewMnemonicFromEntropy encodes the entropy with its checksum as the English BIP-39 mnemonic.
+func NewMnemonicFromEntropy(entropy []byte) (string, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return "", ErrInvalidEntropyLength
+	}
+	checksum := sha256.Sum256(entropy)
+	data := append(append([]byte{}, entropy...), checksum[0])
+	defer zero(data)
+
+	count := (len(entropy)*8 + len(entropy)/4) / mnemonicBitsPerWord
+	words := make([]string, count)
+	for i := range words {
+		words[i] = englishWordlist[readBits(data, i*mnemonicBitsPerWord, mnemonicBitsPerWord)]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// MnemonicToEntropy decodes the mnemonic back to the entropy and verifies the checksum.
+func MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, ErrInvalidMnemonicLength
+	}
+
+	data := make([]byte, (len(words)*mnemonicBitsPerWord+7)/8)
+	for i, word := range words {
+		index, ok := mnemonicWordIndex[word]
+		if !ok {
+			return nil, fmt.Errorf("%w, word %d: %s", ErrUnknownMnemonicWord, i+1, word)
+		}
+		writeBits(data, i*mnemonicBitsPerWord, mnemonicBitsPerWord, index)
+	}
+
+	entropyLength := len(words) * mnemonicBitsPerWord * 32 / 33 / 8
+	entropy := data[:entropyLength]
+	checksumBits := entropyLength / 4
+	checksum := sha256.Sum256(entropy)
+	if readBits(checksum[:], 0, checksumBits) != readBits(data, entropyLength*8, checksumBits) {
+		zero(data)
+		return nil, ErrInvalidMnemonicChecksum
+	}
+	return entropy, nil
+}
+
+// ValidateMnemonic checks that the mnemonic consists of the BIP-39 words and has a valid checksum.
+func ValidateMnemonic(mnemonic string) error {
+	entropy, err := MnemonicToEntropy(mnemonic)
+	if err != nil {
+		return err
+	}
+	zero(entropy)
+	return nil
+}
+
+// NewSeedFromMnemonic validates the mnemonic and stretches it with the optional passphrase into the 64 bytes BIP-39 seed.
+// The passphrase is used as is, it should be NFKD normalized by the caller if it contains non-ASCII characters.
+func NewSeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	normalized := strings.Join(strings.Fields(mnemonic), " ")
+	return pbkdf2.Key([]byte(normalized), []byte(mnemonicSaltPrefix+passphrase), mnemonicIterations, mnemonicSeedLength, sha512.New), nil
+}
+
+// readBits returns count bits of data starting at the bit offset, the most significant bit first.
+func readBits(data []byte, offset, count int) int {
+	result := 0
+	for i := offset; i < offset+count; i++ {
+		result = result<<1 | int(data[i/8]>>(7-i%8)&1)
+	}
+	return result
+}
+
+// writeBits stores the count least significant bits of the value at the bit offset of data.
+func writeBits(data []byte, offset, count, value int) {
+	for i := 0; i < count; i++ {
+		if value>>(count-1-i)&1 == 1 {
+			position := offset + i
+			data[position/8] |= 1 << (7 - position%8)
+		}
+	}
+}
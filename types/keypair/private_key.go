@@ -29,6 +29,15 @@ func (v PrivateKey) ToPem() ([]byte, error) {
 	return v.priv.ToPem()
 }
 
+// Zero overwrites the secret key material held by the PrivateKey, the key can't sign afterwards.
+// The copies made before, e.g. the PEM and DER buffers of the parsing or the big integers
+// used by the SECP256K1 PEM encoding, are out of reach and are left to the garbage collector.
+func (v PrivateKey) Zero() {
+	if priv, ok := v.priv.(interface{ Zero() }); ok {
+		priv.Zero()
+	}
+}
+
 // Sign creates a Casper compatible cryptographic signature, including the algorithm tag prefix
 func (v PrivateKey) Sign(msg []byte) ([]byte, error) {
 	sign, err := v.priv.Sign(msg)
@@ -20,6 +20,7 @@ var (
 	ErrInvalidPublicKeyAlgo = errors.New("invalid public key algorithm")
 	ErrInvalidSignature     = errors.New("invalid signature")
 	ErrEmptyPublicKey       = errors.New("empty public key")
+	ErrInvalidPublicKeySize = errors.New("invalid public key size")
 )
 
 type PublicKeyInternal interface {
@@ -29,8 +30,22 @@ type PublicKeyInternal interface {
 
 type PublicKeyFromBytesDecoder struct{}
 
-func (addr *PublicKeyFromBytesDecoder) FromBytes(bytes []byte) (PublicKey, []byte, error) {
-	panic("implement me")
+func (addr *PublicKeyFromBytesDecoder) FromBytes(source []byte) (PublicKey, []byte, error) {
+	if len(source) == 0 {
+		return PublicKey{}, nil, ErrEmptyPublicKey
+	}
+	size := PublicKey{cryptoAlg: keyAlgorithm(source[0])}.SerializedLength()
+	if size == 0 {
+		return PublicKey{}, nil, ErrInvalidPublicKeyAlgo
+	}
+	if len(source) < size {
+		return PublicKey{}, nil, ErrInvalidPublicKeySize
+	}
+	publicKey, err := NewPublicKeyFromBuffer(bytes.NewBuffer(source[:size]))
+	if err != nil {
+		return PublicKey{}, nil, err
+	}
+	return publicKey, source[size:], nil
 }
 
 type PublicKey struct {
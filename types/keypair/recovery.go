@@ -0,0 +1,60 @@
+package keypair
+
+import (
+	"errors"
+
+	"github.com/make-software/casper-go-sdk/v2/types/keypair/secp256k1"
+)
+
+var (
+	ErrRecoveryNotSupported = errors.New("public key recovery is supported for SECP256K1 keys only")
+	ErrNoMatchingPublicKey  = errors.New("signature doesn't match any of the public keys")
+)
+
+// SignRecoverable creates the 65 bytes R || S || V SECP256K1 signature, the public key can be recovered from it with RecoverPublicKey.
+// The signature doesn't include the algorithm tag, R || S alone is the raw signature accepted by the Casper node.
+func (v PrivateKey) SignRecoverable(msg []byte) ([]byte, error) {
+	priv, ok := v.priv.(secp256k1.PrivateKey)
+	if !ok {
+		return nil, ErrRecoveryNotSupported
+	}
+	return priv.SignRecoverable(msg)
+}
+
+// RecoverPublicKey returns the SECP256K1 public key that created the R || S || V signature of the message,
+// its AccountHash identifies the Casper account.
+func RecoverPublicKey(message []byte, signature []byte) (PublicKey, error) {
+	key, err := secp256k1.RecoverPublicKey(message, signature)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	return PublicKey{cryptoAlg: SECP256K1, key: key}, nil
+}
+
+// MatchSignature returns the candidate that created the signature of the message, e.g. one of the associated keys of an account.
+// The signature is accepted with the algorithm tag, without it or, for SECP256K1, in the recoverable R || S || V form.
+func MatchSignature(message []byte, signature []byte, candidates PublicKeyList) (PublicKey, error) {
+	// the tagged signatures have the same size, the recovery just doesn't find a candidate for them
+	if len(signature) == secp256k1.RecoverableSignatureSize {
+		recovered, err := RecoverPublicKey(message, signature)
+		if err == nil && candidates.Contains(recovered) {
+			return recovered, nil
+		}
+	}
+
+	for _, candidate := range candidates {
+		if candidate.key == nil {
+			continue
+		}
+		tagged := signature
+		if len(signature) == rawSignatureLength {
+			tagged = append([]byte{candidate.cryptoAlg.Byte()}, signature...)
+		} else if len(signature) == 0 || signature[0] != candidate.cryptoAlg.Byte() {
+			continue
+		}
+		if candidate.VerifySignature(message, tagged) == nil {
+			return candidate, nil
+		}
+	}
+	return PublicKey{}, ErrNoMatchingPublicKey
+}
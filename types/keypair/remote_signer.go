@@ -0,0 +1,156 @@
+package keypair
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	// RemoteSignerSignPath is the path of the endpoint that signs the digest, relative to the remote signer URL.
+	RemoteSignerSignPath = "/sign"
+
+	remoteSignerMaxResponseBytes = 64 * 1024
+)
+
+var (
+	ErrRemoteSignerFailed       = errors.New("remote signer request failed")
+	ErrRemoteSignerUnknownKey   = errors.New("remote signer doesn't hold the key")
+	ErrRemoteSignerBadSignature = errors.New("remote signer returned invalid signature")
+)
+
+// RemoteSignRequest is the body of the sign request, the values are hex encoded.
+type RemoteSignRequest struct {
+	PublicKey PublicKey `json:"public_key"`
+	Digest    string    `json:"digest"`
+}
+
+// RemoteSignResponse is the body of the sign response, the signature is hex encoded and includes the algorithm tag prefix.
+type RemoteSignResponse struct {
+	Signature string `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// RemoteSigner is the reference Signer delegating the signing to a service over HTTP.
+// The service receives the RemoteSignRequest with POST on RemoteSignerSignPath and replies with the RemoteSignResponse,
+// NewRemoteSignerHandler implements the service side. The signatures returned by the service are verified before use.
+type RemoteSigner struct {
+	url        string
+	publicKey  PublicKey
+	httpClient *http.Client
+}
+
+// NewRemoteSigner creates the RemoteSigner for the key held by the service available at the url.
+func NewRemoteSigner(url string, publicKey PublicKey, httpClient *http.Client) *RemoteSigner {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &RemoteSigner{
+		url:        url,
+		publicKey:  publicKey,
+		httpClient: httpClient,
+	}
+}
+
+func (s *RemoteSigner) PublicKey() PublicKey {
+	return s.publicKey
+}
+
+func (s *RemoteSigner) Algorithm() KeyAlgorithm {
+	return s.publicKey.cryptoAlg
+}
+
+func (s *RemoteSigner) SignContext(ctx context.Context, digest []byte) ([]byte, error) {
+	body, err := json.Marshal(RemoteSignRequest{
+		PublicKey: s.publicKey,
+		Digest:    hex.EncodeToString(digest),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url+RemoteSignerSignPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w, details: %s", ErrRemoteSignerFailed, err.Error())
+	}
+	defer response.Body.Close()
+
+	var result RemoteSignResponse
+	if err = json.NewDecoder(io.LimitReader(response.Body, remoteSignerMaxResponseBytes)).Decode(&result); err != nil {
+		return nil, fmt.Errorf("%w, status: %d, details: %s", ErrRemoteSignerFailed, response.StatusCode, err.Error())
+	}
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return nil, ErrRemoteSignerUnknownKey
+	case response.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("%w, status: %d, details: %s", ErrRemoteSignerFailed, response.StatusCode, result.Error)
+	}
+
+	signature, err := hex.DecodeString(result.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w, details: %s", ErrRemoteSignerBadSignature, err.Error())
+	}
+	if len(signature) == 0 || signature[0] != s.publicKey.cryptoAlg.Byte() {
+		return nil, fmt.Errorf("%w, details: missing algorithm tag", ErrRemoteSignerBadSignature)
+	}
+	if err = s.publicKey.VerifySignature(digest, signature); err != nil {
+		return nil, fmt.Errorf("%w, details: %s", ErrRemoteSignerBadSignature, err.Error())
+	}
+	return signature, nil
+}
+
+// NewRemoteSignerHandler serves the RemoteSigner protocol with the given signers, it is used as an in-process stand-in for the signing service.
+func NewRemoteSignerHandler(signers ...Signer) http.Handler {
+	byPublicKey := make(map[string]Signer, len(signers))
+	for _, signer := range signers {
+		byPublicKey[signer.PublicKey().String()] = signer
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(RemoteSignerSignPath, func(rw http.ResponseWriter, req *http.Request) {
+		reply := func(status int, response RemoteSignResponse) {
+			rw.Header().Set("Content-Type", "application/json")
+			rw.WriteHeader(status)
+			_ = json.NewEncoder(rw).Encode(response)
+		}
+		if req.Method != http.MethodPost {
+			reply(http.StatusMethodNotAllowed, RemoteSignResponse{Error: "method not allowed"})
+			return
+		}
+
+		var request RemoteSignRequest
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			reply(http.StatusBadRequest, RemoteSignResponse{Error: err.Error()})
+			return
+		}
+		digest, err := hex.DecodeString(request.Digest)
+		if err != nil {
+			reply(http.StatusBadRequest, RemoteSignResponse{Error: err.Error()})
+			return
+		}
+		signer, ok := byPublicKey[request.PublicKey.String()]
+		if !ok {
+			reply(http.StatusNotFound, RemoteSignResponse{Error: ErrRemoteSignerUnknownKey.Error()})
+			return
+		}
+
+		signature, err := signer.SignContext(req.Context(), digest)
+		if err != nil {
+			reply(http.StatusInternalServerError, RemoteSignResponse{Error: err.Error()})
+			return
+		}
+		reply(http.StatusOK, RemoteSignResponse{Signature: hex.EncodeToString(signature)})
+	})
+	return mux
+}
@@ -0,0 +1,161 @@
+package secp256k1
+
+import (
+	"crypto/elliptic"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+const (
+	PemBlockPublicKey       = "PUBLIC KEY"
+	PemBlockLegacyPublicKey = "EC PUBLIC KEY"
+	PemBlockPrivateKey      = "EC PRIVATE KEY"
+	PemBlockPKCS8PrivateKey = "PRIVATE KEY"
+
+	UncompressedPublicKeySize = 65
+	pkcs8PrivateKeyVersion    = 0
+)
+
+var (
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+
+	ErrNotSECP256K1Key = errors.New("key isn't a SECP256K1 key")
+)
+
+type subjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+type pkcs8 struct {
+	Version    int
+	Algorithm  pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// ParsePublicKey parses the public key in the compressed, 33 bytes, or the uncompressed, 65 bytes, SEC 1 form.
+func ParsePublicKey(data []byte) (PublicKey, error) {
+	if len(data) != PublicKeySize && len(data) != UncompressedPublicKeySize {
+		return PublicKey{}, fmt.Errorf("can't parse wrong size of public key: %d", len(data))
+	}
+	key, err := secp256k1.ParsePubKey(data)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	return PublicKey{key: key}, nil
+}
+
+// UncompressedBytes returns the 65 bytes uncompressed form of the public key, Bytes returns the compressed one used by Casper.
+func (v PublicKey) UncompressedBytes() []byte {
+	return v.key.SerializeUncompressed()
+}
+
+// Key returns the underlying curve point.
+func (v PublicKey) Key() *secp256k1.PublicKey {
+	return v.key
+}
+
+// PublicKeyToDer encodes the public key as the PKIX SubjectPublicKeyInfo with the uncompressed point,
+// the format of the casper-client public_key.pem.
+func PublicKeyToDer(pub *secp256k1.PublicKey) ([]byte, error) {
+	curve, err := asn1.Marshal(oid)
+	if err != nil {
+		return nil, err
+	}
+	point := pub.SerializeUncompressed()
+	return asn1.Marshal(subjectPublicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: curve}},
+		PublicKey: asn1.BitString{Bytes: point, BitLength: len(point) * 8},
+	})
+}
+
+// NewPublicKeyFromDer parses the PKIX SubjectPublicKeyInfo of the SECP256K1 public key.
+func NewPublicKeyFromDer(der []byte) (PublicKey, error) {
+	var info subjectPublicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil {
+		return PublicKey{}, fmt.Errorf("x509: failed to parse public key: %s", err.Error())
+	} else if len(rest) != 0 {
+		return PublicKey{}, errors.New("x509: trailing data after public key")
+	}
+	if !isSECP256K1Algorithm(info.Algorithm) {
+		return PublicKey{}, ErrNotSECP256K1Key
+	}
+	return ParsePublicKey(info.PublicKey.RightAlign())
+}
+
+// NewPublicKeyFromPem parses the PKIX PEM public key, the raw point in the EC PUBLIC KEY block written by PublicKeyToPem is accepted as well.
+func NewPublicKeyFromPem(content []byte) (PublicKey, error) {
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return PublicKey{}, fmt.Errorf("key not found")
+	}
+	switch block.Type {
+	case PemBlockPublicKey:
+		return NewPublicKeyFromDer(block.Bytes)
+	case PemBlockLegacyPublicKey:
+		return ParsePublicKey(block.Bytes)
+	default:
+		return PublicKey{}, fmt.Errorf("unexpected PEM block: %s", block.Type)
+	}
+}
+
+// PrivateKeyToDer encodes the private key in the SEC 1 form, the format of the casper-client secret_key.pem.
+func PrivateKeyToDer(priv *secp256k1.PrivateKey) ([]byte, error) {
+	key := priv.ToECDSA()
+
+	privateKey := make([]byte, (key.Curve.Params().N.BitLen()+7)/8)
+	privBytes, err := asn1.Marshal(ecPrivateKey{
+		Version:       1,
+		PrivateKey:    key.D.FillBytes(privateKey),
+		NamedCurveOID: oid,
+		PublicKey:     asn1.BitString{Bytes: elliptic.Marshal(key.Curve, key.X, key.Y)}, //nolint
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling EC private key: %s", err)
+	}
+	return privBytes, nil
+}
+
+// NewPrivateKeyFromDer parses the private key encoded in the SEC 1 form or wrapped into PKCS #8.
+func NewPrivateKeyFromDer(der []byte) (PrivateKey, error) {
+	var wrapped pkcs8
+	if _, err := asn1.Unmarshal(der, &wrapped); err == nil && wrapped.Version == pkcs8PrivateKeyVersion {
+		if !isSECP256K1Algorithm(wrapped.Algorithm) {
+			return PrivateKey{}, ErrNotSECP256K1Key
+		}
+		der = wrapped.PrivateKey
+	}
+
+	var privKey ecPrivateKey
+	if _, err := asn1.Unmarshal(der, &privKey); err != nil {
+		return PrivateKey{}, fmt.Errorf("x509: failed to parse EC private key: %s", err.Error())
+	}
+	if privKey.Version != 1 {
+		return PrivateKey{}, fmt.Errorf("x509: unknown EC private key version %d", privKey.Version)
+	}
+	if len(privKey.NamedCurveOID) != 0 && !privKey.NamedCurveOID.Equal(oid) {
+		return PrivateKey{}, ErrNotSECP256K1Key
+	}
+	return NewPrivateKeyFromBytes(privKey.PrivateKey)
+}
+
+// Bytes returns the 32 bytes raw form of the private key.
+func (v PrivateKey) Bytes() []byte {
+	return v.key.Serialize()
+}
+
+func isSECP256K1Algorithm(algorithm pkix.AlgorithmIdentifier) bool {
+	var curve asn1.ObjectIdentifier
+	if !algorithm.Algorithm.Equal(oidPublicKeyECDSA) {
+		return false
+	}
+	if _, err := asn1.Unmarshal(algorithm.Parameters.FullBytes, &curve); err != nil {
+		return false
+	}
+	return curve.Equal(oid)
+}
@@ -1,7 +1,6 @@
 package secp256k1
 
 import (
-	"crypto/elliptic"
 	"encoding/asn1"
 	"encoding/pem"
 	"fmt"
@@ -46,22 +45,14 @@ func GeneratePrivateKey() (PrivateKey, error) {
 }
 
 func PrivateKeyToPem(priv *secp256k1.PrivateKey) ([]byte, error) {
-	key := priv.ToECDSA()
-
-	privateKey := make([]byte, (key.Curve.Params().N.BitLen()+7)/8)
-	privBytes, err := asn1.Marshal(ecPrivateKey{
-		Version:       1,
-		PrivateKey:    key.D.FillBytes(privateKey),
-		NamedCurveOID: oid,
-		PublicKey:     asn1.BitString{Bytes: elliptic.Marshal(key.Curve, key.X, key.Y)}, //nolint
-	})
+	privBytes, err := PrivateKeyToDer(priv)
 	if err != nil {
-		return nil, fmt.Errorf("marshalling EC private key: %s", err)
+		return nil, err
 	}
 
 	return pem.EncodeToMemory(
 		&pem.Block{
-			Type:  "EC PRIVATE KEY",
+			Type:  PemBlockPrivateKey,
 			Bytes: privBytes,
 		},
 	), nil
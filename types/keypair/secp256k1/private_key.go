@@ -25,6 +25,11 @@ func (v PrivateKey) ToPem() ([]byte, error) {
 	return PrivateKeyToPem(v.key)
 }
 
+// Zero overwrites the secret scalar, the key can't sign afterwards.
+func (v PrivateKey) Zero() {
+	v.key.Zero()
+}
+
 func NewPrivateKeyFromPem(content []byte) (PrivateKey, error) {
 	private, err := PemToPrivateKey(content)
 	if err != nil {
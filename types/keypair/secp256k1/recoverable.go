@@ -0,0 +1,58 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+const (
+	// SignatureSize is the size of the compact R || S signature produced by Sign.
+	SignatureSize = 64
+	// RecoverableSignatureSize is the size of the R || S || V signature produced by SignRecoverable.
+	RecoverableSignatureSize = 65
+
+	// compactSigMagicOffset and compactSigCompPubKey form the header of the decred compact signature.
+	compactSigMagicOffset = 27
+	compactSigCompPubKey  = 4
+	// maxRecoveryID is the highest recovery id, the bit 0 is the oddness of R.y and the bit 1 the overflow of R.x.
+	maxRecoveryID = 3
+)
+
+var ErrInvalidRecoverableSignature = errors.New("invalid recoverable signature")
+
+// SignRecoverable signs the SHA-256 digest of the message, as Sign does, and returns the 65 bytes R || S || V signature.
+// V is the recovery id from 0 to 3, R || S is the same as the signature returned by Sign.
+func (v PrivateKey) SignRecoverable(mes []byte) ([]byte, error) {
+	hash := sha256.Sum256(mes)
+	compact := ecdsa.SignCompact(v.key, hash[:], true)
+	recoveryID := compact[0] - compactSigMagicOffset - compactSigCompPubKey
+	return append(compact[1:], recoveryID), nil
+}
+
+// RecoverPublicKey returns the public key that created the R || S || V signature of the message.
+// V is accepted both as the recovery id and with the offset of 27 used by Ethereum.
+func RecoverPublicKey(mes []byte, signature []byte) (PublicKey, error) {
+	if len(signature) != RecoverableSignatureSize {
+		return PublicKey{}, fmt.Errorf("%w, expected %d bytes, got %d bytes", ErrInvalidRecoverableSignature, RecoverableSignatureSize, len(signature))
+	}
+	recoveryID := signature[SignatureSize]
+	if recoveryID >= compactSigMagicOffset {
+		recoveryID -= compactSigMagicOffset
+	}
+	if recoveryID > maxRecoveryID {
+		return PublicKey{}, fmt.Errorf("%w, recovery id: %d", ErrInvalidRecoverableSignature, signature[SignatureSize])
+	}
+
+	compact := make([]byte, 0, RecoverableSignatureSize)
+	compact = append(compact, compactSigMagicOffset+compactSigCompPubKey+recoveryID)
+	compact = append(compact, signature[:SignatureSize]...)
+	hash := sha256.Sum256(mes)
+	key, _, err := ecdsa.RecoverCompact(compact, hash[:])
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("%w, details: %s", ErrInvalidRecoverableSignature, err.Error())
+	}
+	return PublicKey{key: key}, nil
+}
@@ -0,0 +1,25 @@
+package keypair
+
+import (
+	"context"
+)
+
+// KeyAlgorithm is the signature algorithm of the key, ED25519 or SECP256K1.
+type KeyAlgorithm = keyAlgorithm
+
+// Signer creates Casper compatible signatures with a key that may live outside the process, e.g. in an HSM, KMS or a remote service.
+// The signature returned by SignContext includes the algorithm tag prefix, as the one created by PrivateKey.Sign.
+type Signer interface {
+	PublicKey() PublicKey
+	Algorithm() KeyAlgorithm
+	SignContext(ctx context.Context, digest []byte) ([]byte, error)
+}
+
+func (v PrivateKey) Algorithm() KeyAlgorithm {
+	return v.alg
+}
+
+// SignContext implements Signer with the in-memory key, the context is ignored.
+func (v PrivateKey) SignContext(_ context.Context, digest []byte) ([]byte, error) {
+	return v.Sign(digest)
+}
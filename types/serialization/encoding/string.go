@@ -43,6 +43,23 @@ func (enc StringToBytesEncoder) Bytes() ([]byte, error) {
 	return clvalue.NewCLString(enc.val).Bytes(), nil
 }
 
+type BytesFromBytesDecoder struct{}
+
+func (dec *BytesFromBytesDecoder) FromBytes(bytes []byte) ([]byte, []byte, error) {
+	length, remainder, err := NewU32FromBytesDecoder().FromBytes(bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if uint64(len(remainder)) < uint64(length) {
+		return nil, nil, ErrInvalidBytesStructure
+	}
+
+	result := make([]byte, length)
+	copy(result, remainder[:length])
+	return result, remainder[length:], nil
+}
+
 func BytesSerializedLength(val []byte) int {
 	return U32SerializedLength + len(val)
 }
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+)
+
+var ErrInvalidSessionArg = errors.New("invalid session argument")
+
+// SessionArgJSON is the element of the JSON array accepted by the casper-client --session-args-json option,
+// e.g. {"name": "amount", "type": "U512", "value": "1000000"}.
+type SessionArgJSON struct {
+	Name string `json:"name"`
+	// Type is the CLType in its JSON form, e.g. "U512" or {"Option": "U64"}
+	Type  json.RawMessage `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// ParseSessionArg parses the argument in the casper-client --session-arg syntax name:type='value',
+// e.g. amount:u512='1000000' or opt:opt_u64=null, see clvalue.NewCLTypeFromSimpleName for the type names.
+func ParseSessionArg(arg string) (string, clvalue.CLValue, error) {
+	name, rest, found := strings.Cut(arg, ":")
+	if !found {
+		return "", clvalue.CLValue{}, fmt.Errorf("%w, arg: %s, details: name:type='value' is expected, ':' is missing", ErrInvalidSessionArg, arg)
+	}
+	typeName, value, found := strings.Cut(rest, "=")
+	if !found {
+		return "", clvalue.CLValue{}, fmt.Errorf("%w, arg: %s, details: name:type='value' is expected, '=' is missing", ErrInvalidSessionArg, arg)
+	}
+	name, typeName = strings.TrimSpace(name), strings.TrimSpace(typeName)
+	if name == "" {
+		return "", clvalue.CLValue{}, fmt.Errorf("%w, arg: %s, details: name is empty", ErrInvalidSessionArg, arg)
+	}
+	if len(value) >= 2 && strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") {
+		value = value[1 : len(value)-1]
+	} else if strings.Contains(value, "'") {
+		return "", clvalue.CLValue{}, fmt.Errorf("%w, arg: %s, details: value must be enclosed in single quotes", ErrInvalidSessionArg, arg)
+	}
+
+	result, err := clvalue.NewCLValueFromSimple(typeName, value)
+	if err != nil {
+		return "", clvalue.CLValue{}, fmt.Errorf("%w, arg: %s, details: %w", ErrInvalidSessionArg, name, err)
+	}
+	return name, result, nil
+}
+
+// NewArgsFromSessionArgs builds the Args from the arguments in the casper-client --session-arg syntax.
+func NewArgsFromSessionArgs(args []string) (*Args, error) {
+	result := &Args{}
+	for _, arg := range args {
+		name, value, err := ParseSessionArg(arg)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = result.Find(name); err == nil {
+			return nil, fmt.Errorf("%w, arg: %s, details: duplicated name", ErrInvalidSessionArg, name)
+		}
+		result.AddArgument(name, value)
+	}
+	return result, nil
+}
+
+// NewArgsFromSessionArgsJSON builds the Args from the JSON array of the casper-client --session-args-json option,
+// see clvalue.NewCLValueFromJSON for the representation of the values.
+func NewArgsFromSessionArgsJSON(source []byte) (*Args, error) {
+	var jsonArgs []SessionArgJSON
+	if err := json.Unmarshal(source, &jsonArgs); err != nil {
+		return nil, fmt.Errorf("%w, details: array of {\"name\", \"type\", \"value\"} objects is expected, %s", ErrInvalidSessionArg, err.Error())
+	}
+
+	result := &Args{}
+	for i, arg := range jsonArgs {
+		if arg.Name == "" {
+			return nil, fmt.Errorf("%w, arg: [%d], details: name is empty", ErrInvalidSessionArg, i)
+		}
+		if _, err := result.Find(arg.Name); err == nil {
+			return nil, fmt.Errorf("%w, arg: %s, details: duplicated name", ErrInvalidSessionArg, arg.Name)
+		}
+		if len(arg.Type) == 0 {
+			return nil, fmt.Errorf("%w, arg: %s, details: type is missing", ErrInvalidSessionArg, arg.Name)
+		}
+		clType, err := cltype.FromRawJson(arg.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%w, arg: %s, details: unknown type %s, %w", ErrInvalidSessionArg, arg.Name, string(arg.Type), err)
+		}
+		if len(arg.Value) == 0 {
+			return nil, fmt.Errorf("%w, arg: %s, details: value is missing", ErrInvalidSessionArg, arg.Name)
+		}
+		value, err := clvalue.NewCLValueFromJSON(clType, arg.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w, arg: %s, details: %w", ErrInvalidSessionArg, arg.Name, err)
+		}
+		result.AddArgument(arg.Name, value)
+	}
+	return result, nil
+}
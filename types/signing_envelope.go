@@ -0,0 +1,301 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization/encoding"
+)
+
+// SigningEnvelopeVersion is the version of the envelope format produced by this package.
+const SigningEnvelopeVersion uint8 = 1
+
+var (
+	ErrUnsupportedEnvelopeVersion = errors.New("unsupported signing envelope version")
+	ErrEnvelopeHashMismatch       = errors.New("envelope hash doesn't match the transaction")
+	ErrEnvelopeChainNameMismatch  = errors.New("envelope chain name doesn't match the transaction")
+	ErrEnvelopeTransactionsDiffer = errors.New("envelopes contain different transactions")
+	ErrEmptyEnvelope              = errors.New("envelope doesn't contain a transaction")
+	ErrEnvelopeInvalidBody        = errors.New("envelope contains an invalid transaction")
+)
+
+// SigningEnvelope is the portable container moving an unsigned or partially signed transaction
+// between the machine that creates it and the machines, possibly air-gapped, that sign it.
+type SigningEnvelope struct {
+	// Version of the envelope format.
+	Version uint8 `json:"version"`
+	// Expected hash of the transaction, it is verified against the body on import.
+	Hash key.Hash `json:"hash"`
+	// Name of the chain the transaction is executed on.
+	ChainName string `json:"chain_name"`
+	// Human-readable description of the transaction shown to the signers.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// The transaction with the approvals collected so far.
+	Body TransactionWrapper `json:"body"`
+}
+
+// NewSigningEnvelope exports the Transaction constructed from a Deploy or a TransactionV1 into the envelope.
+func NewSigningEnvelope(transaction Transaction, metadata map[string]string) (*SigningEnvelope, error) {
+	var body TransactionWrapper
+	switch {
+	case transaction.GetDeploy() != nil:
+		deploy := *transaction.GetDeploy()
+		deploy.Approvals = append([]Approval{}, deploy.Approvals...)
+		body.Deploy = &deploy
+	case transaction.GetTransactionV1() != nil:
+		transactionV1 := *transaction.GetTransactionV1()
+		transactionV1.Approvals = append([]Approval{}, transactionV1.Approvals...)
+		body.TransactionV1 = &transactionV1
+	default:
+		return nil, ErrEmptyEnvelope
+	}
+
+	envelope := SigningEnvelope{
+		Version:   SigningEnvelopeVersion,
+		Hash:      transaction.Hash,
+		ChainName: transaction.ChainName,
+		Metadata:  metadata,
+		Body:      body,
+	}
+	if err := envelope.Verify(); err != nil {
+		return nil, err
+	}
+	return &envelope, nil
+}
+
+// NewSigningEnvelopeFromJSON imports the envelope from JSON and verifies it.
+func NewSigningEnvelopeFromJSON(data []byte) (*SigningEnvelope, error) {
+	var envelope SigningEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+	if err := envelope.Verify(); err != nil {
+		return nil, err
+	}
+	return &envelope, nil
+}
+
+// NewSigningEnvelopeFromBytes imports the envelope from the binary representation produced by Bytes and verifies it.
+func NewSigningEnvelopeFromBytes(source []byte) (*SigningEnvelope, error) {
+	if len(source) == 0 {
+		return nil, decodingError("version", serialization.ErrEarlyEndOfStream)
+	}
+	if source[0] != SigningEnvelopeVersion {
+		return nil, fmt.Errorf("%w, version: %d", ErrUnsupportedEnvelopeVersion, source[0])
+	}
+
+	hash, remainder, err := decodeHash(source[1:])
+	if err != nil {
+		return nil, decodingError("hash", err)
+	}
+	chainName, remainder, err := (&encoding.StringFromBytesDecoder{}).FromBytes(remainder)
+	if err != nil {
+		return nil, decodingError("chain_name", err)
+	}
+	count, remainder, err := encoding.NewU32FromBytesDecoder().FromBytes(remainder)
+	if err != nil {
+		return nil, decodingError("metadata", err)
+	}
+	var metadata map[string]string
+	if count > 0 {
+		metadata = make(map[string]string, count)
+	}
+	for i := uint32(0); i < count; i++ {
+		var name, value string
+		if name, remainder, err = (&encoding.StringFromBytesDecoder{}).FromBytes(remainder); err != nil {
+			return nil, decodingError(fmt.Sprintf("metadata[%d].key", i), err)
+		}
+		if value, remainder, err = (&encoding.StringFromBytesDecoder{}).FromBytes(remainder); err != nil {
+			return nil, decodingError(fmt.Sprintf("metadata[%d].value", i), err)
+		}
+		metadata[name] = value
+	}
+	body, remainder, err := (&TransactionWrapperFromBytesDecoder{}).FromBytes(remainder)
+	if err != nil {
+		return nil, decodingError("body", err)
+	}
+	if len(remainder) != 0 {
+		return nil, fmt.Errorf("%w, %d bytes after the envelope", ErrTrailingBytes, len(remainder))
+	}
+
+	envelope := SigningEnvelope{
+		Version:   SigningEnvelopeVersion,
+		Hash:      hash,
+		ChainName: chainName,
+		Metadata:  metadata,
+		Body:      *body,
+	}
+	if err = envelope.Verify(); err != nil {
+		return nil, err
+	}
+	return &envelope, nil
+}
+
+// Bytes returns the compact binary representation of the envelope:
+// version, hash, chain name, metadata sorted by key and the versioned transaction.
+func (e *SigningEnvelope) Bytes() ([]byte, error) {
+	bodyBytes, err := e.Body.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []byte{e.Version}
+	result = append(result, e.Hash.Bytes()...)
+	result = append(result, clvalue.NewCLString(e.ChainName).Bytes()...)
+
+	names := make([]string, 0, len(e.Metadata))
+	for name := range e.Metadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result = append(result, clvalue.SizeToBytes(len(names))...)
+	for _, name := range names {
+		result = append(result, clvalue.NewCLString(name).Bytes()...)
+		result = append(result, clvalue.NewCLString(e.Metadata[name]).Bytes()...)
+	}
+	return append(result, bodyBytes...), nil
+}
+
+// Verify checks the version of the envelope and that the hash and chain name match the transaction body.
+// The approvals collected so far are verified as well. The errors of the transaction validation, e.g. ErrInvalidTransactionHash
+// or ErrInvalidApprovalSignature, are wrapped with ErrEnvelopeInvalidBody.
+func (e *SigningEnvelope) Verify() error {
+	if e.Version != SigningEnvelopeVersion {
+		return fmt.Errorf("%w, version: %d", ErrUnsupportedEnvelopeVersion, e.Version)
+	}
+
+	var (
+		hash      key.Hash
+		chainName string
+		err       error
+	)
+	switch {
+	case e.Body.Deploy != nil:
+		hash, chainName = e.Body.Deploy.Hash, e.Body.Deploy.Header.ChainName
+		err = e.Body.Deploy.Validate()
+	case e.Body.TransactionV1 != nil:
+		hash, chainName = e.Body.TransactionV1.Hash, e.Body.TransactionV1.Payload.ChainName
+		err = e.Body.TransactionV1.Validate()
+	default:
+		return ErrEmptyEnvelope
+	}
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrEnvelopeInvalidBody, err)
+	}
+	if hash != e.Hash {
+		return ErrEnvelopeHashMismatch
+	}
+	if chainName != e.ChainName {
+		return ErrEnvelopeChainNameMismatch
+	}
+	return nil
+}
+
+// Transaction returns the transaction of the envelope with the approvals collected so far.
+func (e *SigningEnvelope) Transaction() (Transaction, error) {
+	switch {
+	case e.Body.Deploy != nil:
+		return NewTransactionFromDeploy(*e.Body.Deploy), nil
+	case e.Body.TransactionV1 != nil:
+		return NewTransactionFromTransactionV1(*e.Body.TransactionV1), nil
+	default:
+		return Transaction{}, ErrEmptyEnvelope
+	}
+}
+
+// Approvals returns the approvals collected so far.
+func (e *SigningEnvelope) Approvals() []Approval {
+	switch {
+	case e.Body.Deploy != nil:
+		return e.Body.Deploy.Approvals
+	case e.Body.TransactionV1 != nil:
+		return e.Body.TransactionV1.Approvals
+	default:
+		return nil
+	}
+}
+
+// Sign adds the approval of the key to the envelope, signing twice with the same key has no effect.
+func (e *SigningEnvelope) Sign(keys keypair.PrivateKey) error {
+	return e.SignWith(context.Background(), keys)
+}
+
+// SignWith adds the approval created by the Signer, signing twice with the same key has no effect.
+func (e *SigningEnvelope) SignWith(ctx context.Context, signer keypair.Signer) error {
+	signature, err := signer.SignContext(ctx, e.Hash.Bytes())
+	if err != nil {
+		return err
+	}
+	return e.addApprovals([]Approval{{Signer: signer.PublicKey(), Signature: signature}})
+}
+
+// AttachApprovals copies the approvals of the other envelope of the same transaction, skipping the signers already present.
+func (e *SigningEnvelope) AttachApprovals(other *SigningEnvelope) error {
+	if other.Hash != e.Hash {
+		return fmt.Errorf("%w, expected: %s, actual: %s", ErrEnvelopeTransactionsDiffer, e.Hash, other.Hash)
+	}
+	if err := other.Verify(); err != nil {
+		return err
+	}
+	return e.addApprovals(other.Approvals())
+}
+
+// MergeSigningEnvelopes combines the approvals of several signers of the same transaction into a new envelope.
+// The metadata is taken from the first envelope.
+func MergeSigningEnvelopes(envelopes ...*SigningEnvelope) (*SigningEnvelope, error) {
+	if len(envelopes) == 0 {
+		return nil, ErrEmptyEnvelope
+	}
+	transaction, err := envelopes[0].Transaction()
+	if err != nil {
+		return nil, err
+	}
+	result, err := NewSigningEnvelope(transaction, envelopes[0].Metadata)
+	if err != nil {
+		return nil, err
+	}
+	for _, envelope := range envelopes[1:] {
+		if err = result.AttachApprovals(envelope); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (e *SigningEnvelope) addApprovals(approvals []Approval) error {
+	var target *[]Approval
+	switch {
+	case e.Body.Deploy != nil:
+		target = &e.Body.Deploy.Approvals
+	case e.Body.TransactionV1 != nil:
+		target = &e.Body.TransactionV1.Approvals
+	default:
+		return ErrEmptyEnvelope
+	}
+
+	for _, approval := range approvals {
+		if approval.Signer.VerifySignature(e.Hash.Bytes(), approval.Signature) != nil {
+			return ErrInvalidApprovalSignature
+		}
+		if !hasApprovalOf(*target, approval.Signer) {
+			*target = append(*target, approval)
+		}
+	}
+	return nil
+}
+
+func hasApprovalOf(approvals []Approval, signer keypair.PublicKey) bool {
+	for _, approval := range approvals {
+		if approval.Signer.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
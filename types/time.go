@@ -46,12 +46,12 @@ type TimestampFromBytesDecoder struct{}
 
 func (addr *TimestampFromBytesDecoder) FromBytes(bytes []byte) (*Timestamp, []byte, error) {
 	u64Decoder := encoding.U64FromBytesDecoder{}
-	seconds, remainder, err := u64Decoder.FromBytes(bytes)
+	millis, remainder, err := u64Decoder.FromBytes(bytes)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	t := time.Unix(int64(seconds), 0).UTC()
+	t := time.UnixMilli(int64(millis)).UTC()
 	timestamp := Timestamp(t)
 
 	return &timestamp, remainder, nil
@@ -106,7 +106,7 @@ func (addr *DurationFromBytesDecoder) FromBytes(bytes []byte) (*Duration, []byte
 		return nil, nil, err
 	}
 
-	t := time.Duration(raw)
+	t := time.Duration(raw) * time.Millisecond
 	duration := Duration(t)
 
 	return &duration, remainder, nil
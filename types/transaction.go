@@ -1,14 +1,18 @@
 package types
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 
 	"golang.org/x/crypto/blake2b"
 
 	"github.com/make-software/casper-go-sdk/v2/types/key"
 	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization"
+	"github.com/make-software/casper-go-sdk/v2/types/serialization/encoding"
 )
 
 var (
@@ -17,6 +21,20 @@ var (
 	ErrInvalidApprovalSignature = errors.New("invalid approval signature")
 )
 
+const (
+	TransactionV1HashFieldIndex uint16 = iota
+	TransactionV1PayloadFieldIndex
+	TransactionV1ApprovalsFieldIndex
+)
+
+// Variants of the versioned transaction, the variant body is stored in the TransactionVariantFieldIndex field.
+const (
+	TransactionDeployTag uint8 = iota
+	TransactionV1Tag
+)
+
+const TransactionVariantFieldIndex uint16 = 1
+
 type TransactionCategory uint
 
 const (
@@ -157,6 +175,105 @@ type TransactionWrapper struct {
 	TransactionV1 *TransactionV1 `json:"Version1,omitempty"`
 }
 
+// Bytes returns the versioned binary representation of the wrapped Deploy or TransactionV1.
+func (t *TransactionWrapper) Bytes() ([]byte, error) {
+	var (
+		tag         uint8
+		variantData []byte
+		err         error
+	)
+	switch {
+	case t.Deploy != nil:
+		tag = TransactionDeployTag
+		variantData, err = t.Deploy.Bytes()
+	case t.TransactionV1 != nil:
+		tag = TransactionV1Tag
+		variantData, err = t.TransactionV1.Bytes()
+	default:
+		return nil, errors.New("unknown transaction version")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	builder, err := serialization.NewCallTableSerializationEnvelopeBuilder([]int{encoding.U8SerializedLength, len(variantData)})
+	if err != nil {
+		return nil, err
+	}
+	if err = builder.AddField(TagFieldIndex, []byte{tag}); err != nil {
+		return nil, err
+	}
+	if err = builder.AddField(TransactionVariantFieldIndex, variantData); err != nil {
+		return nil, err
+	}
+	return builder.BinaryPayloadBytes()
+}
+
+// TransactionWrapperFromBytesDecoder detects the version of the transaction by its tag and decodes it.
+type TransactionWrapperFromBytesDecoder struct{}
+
+func (d *TransactionWrapperFromBytesDecoder) FromBytes(source []byte) (*TransactionWrapper, []byte, error) {
+	window, remainder, err := startCallTable(2, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = window.VerifyIndex(TagFieldIndex); err != nil {
+		return nil, nil, decodingError("tag", err)
+	}
+	tag, nextWindow, err := serialization.DeserializeAndMaybeNext[uint8](window, &encoding.U8FromBytesDecoder{})
+	if err != nil {
+		return nil, nil, decodingError("tag", err)
+	}
+	if err = expectField(nextWindow, TransactionVariantFieldIndex); err != nil {
+		return nil, nil, decodingError("variant", err)
+	}
+
+	var result TransactionWrapper
+	switch tag {
+	case TransactionDeployTag:
+		result.Deploy, nextWindow, err = serialization.DeserializeAndMaybeNext[*Deploy](nextWindow, &DeployFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, decodingError("deploy", err)
+		}
+	case TransactionV1Tag:
+		result.TransactionV1, nextWindow, err = serialization.DeserializeAndMaybeNext[*TransactionV1](nextWindow, &TransactionV1FromBytesDecoder{})
+		if err != nil {
+			return nil, nil, decodingError("transaction_v1", err)
+		}
+	default:
+		return nil, nil, decodingError("tag", fmt.Errorf("%w, unknown transaction version %d", serialization.ErrFormatting, tag))
+	}
+	if nextWindow != nil {
+		return nil, nil, decodingError("variant", ErrTrailingBytes)
+	}
+	return &result, remainder, nil
+}
+
+// NewTransactionWrapperFromBytes decodes the versioned transaction and rejects the bytes left after it.
+func NewTransactionWrapperFromBytes(source []byte) (*TransactionWrapper, error) {
+	wrapper, remainder, err := (&TransactionWrapperFromBytesDecoder{}).FromBytes(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(remainder) != 0 {
+		return nil, fmt.Errorf("%w, %d bytes after the transaction", ErrTrailingBytes, len(remainder))
+	}
+	return wrapper, nil
+}
+
+// NewTransactionFromBytes decodes the versioned transaction, either Deploy or TransactionV1, into the Transaction.
+func NewTransactionFromBytes(source []byte) (Transaction, error) {
+	wrapper, err := NewTransactionWrapperFromBytes(source)
+	if err != nil {
+		return Transaction{}, err
+	}
+	if wrapper.Deploy != nil {
+		return NewTransactionFromDeploy(*wrapper.Deploy), nil
+	}
+	return NewTransactionFromTransactionV1(*wrapper.TransactionV1), nil
+}
+
 type TransactionV1 struct {
 	// Hex-encoded TransactionV1 hash
 	Hash key.Hash `json:"hash"`
@@ -193,12 +310,17 @@ func (t *TransactionHash) ToHash() key.Hash {
 }
 
 func (t *TransactionV1) Sign(keys keypair.PrivateKey) error {
-	signature, err := keys.Sign(t.Hash.Bytes())
+	return t.SignWith(context.Background(), keys)
+}
+
+// SignWith adds the approval created by the Signer, e.g. the key stored in an HSM or a remote service.
+func (t *TransactionV1) SignWith(ctx context.Context, signer keypair.Signer) error {
+	signature, err := signer.SignContext(ctx, t.Hash.Bytes())
 	if err != nil {
 		return err
 	}
 	approval := Approval{
-		Signer:    keys.PublicKey(),
+		Signer:    signer.PublicKey(),
 		Signature: signature,
 	}
 
@@ -220,13 +342,82 @@ func (t *TransactionV1) Validate() error {
 		return ErrInvalidTransactionHash
 	}
 
-	for _, one := range t.Approvals {
-		if one.Signer.VerifySignature(t.Hash.Bytes(), one.Signature) != nil {
-			return ErrInvalidApprovalSignature
-		}
+	return verifyApprovals(t.Hash, t.Approvals)
+}
+
+// Bytes returns the binary representation of the TransactionV1: the hash, payload and approvals stored in the call table.
+func (t *TransactionV1) Bytes() ([]byte, error) {
+	payloadBytes, err := t.Payload.Bytes()
+	if err != nil {
+		return nil, err
 	}
+	approvals := approvalsBytes(t.Approvals)
 
-	return nil
+	builder, err := serialization.NewCallTableSerializationEnvelopeBuilder([]int{key.ByteHashLen, len(payloadBytes), len(approvals)})
+	if err != nil {
+		return nil, err
+	}
+	if err = builder.AddField(TransactionV1HashFieldIndex, t.Hash.Bytes()); err != nil {
+		return nil, err
+	}
+	if err = builder.AddField(TransactionV1PayloadFieldIndex, payloadBytes); err != nil {
+		return nil, err
+	}
+	if err = builder.AddField(TransactionV1ApprovalsFieldIndex, approvals); err != nil {
+		return nil, err
+	}
+	return builder.BinaryPayloadBytes()
+}
+
+// TransactionV1FromBytesDecoder decodes the TransactionV1 from the representation produced by Bytes.
+type TransactionV1FromBytesDecoder struct{}
+
+func (d *TransactionV1FromBytesDecoder) FromBytes(source []byte) (*TransactionV1, []byte, error) {
+	window, remainder, err := startCallTable(3, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = window.VerifyIndex(TransactionV1HashFieldIndex); err != nil {
+		return nil, nil, decodingError("hash", err)
+	}
+	hash, window, err := serialization.DeserializeAndMaybeNext[key.Hash](window, &hashFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, decodingError("hash", err)
+	}
+
+	if err = expectField(window, TransactionV1PayloadFieldIndex); err != nil {
+		return nil, nil, decodingError("payload", err)
+	}
+	payload, window, err := serialization.DeserializeAndMaybeNext[*TransactionV1Payload](window, &TransactionV1PayloadFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, decodingError("payload", err)
+	}
+
+	if err = expectField(window, TransactionV1ApprovalsFieldIndex); err != nil {
+		return nil, nil, decodingError("approvals", err)
+	}
+	approvals, window, err := serialization.DeserializeAndMaybeNext[[]Approval](window, &approvalsFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if window != nil {
+		return nil, nil, decodingError("approvals", ErrTrailingBytes)
+	}
+
+	return NewTransactionV1(hash, *payload, approvals), remainder, nil
+}
+
+// NewTransactionV1FromBytes decodes the TransactionV1 and rejects the bytes left after it.
+func NewTransactionV1FromBytes(source []byte) (*TransactionV1, error) {
+	transaction, remainder, err := (&TransactionV1FromBytesDecoder{}).FromBytes(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(remainder) != 0 {
+		return nil, fmt.Errorf("%w, %d bytes after the transaction", ErrTrailingBytes, len(remainder))
+	}
+	return transaction, nil
 }
 
 func NewTransactionV1(hash key.Hash, payload TransactionV1Payload, approvals []Approval) *TransactionV1 {
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+var (
+	ErrMissingChainName           = errors.New("chain name is not set")
+	ErrMissingInitiator           = errors.New("initiator is not set")
+	ErrMissingTransactionArgument = errors.New("required transaction argument is not set")
+	ErrInvalidTransactionArgument = errors.New("invalid transaction argument")
+)
+
+const (
+	// DefaultTransactionTTL is the TTL applied by the builders if it isn't set explicitly.
+	DefaultTransactionTTL = 30 * time.Minute
+	// DefaultGasPriceTolerance is the gas price tolerance of the default Fixed pricing mode.
+	DefaultGasPriceTolerance uint8 = 5
+)
+
+// TransactionV1Builder keeps the fields shared by all TransactionV1 builders.
+// It is embedded into the specific builders, its setters return the embedding builder to keep the calls chained.
+type TransactionV1Builder[T any] struct {
+	self          T
+	initiatorAddr *InitiatorAddr
+	timestamp     Timestamp
+	ttl           Duration
+	chainName     string
+	pricingMode   PricingMode
+	scheduling    TransactionScheduling
+}
+
+func newTransactionV1Builder[T any](self T) TransactionV1Builder[T] {
+	return TransactionV1Builder[T]{
+		self:      self,
+		timestamp: Timestamp(time.Now()),
+		ttl:       Duration(DefaultTransactionTTL),
+		pricingMode: PricingMode{
+			Fixed: &FixedMode{
+				GasPriceTolerance: DefaultGasPriceTolerance,
+			},
+		},
+		scheduling: TransactionScheduling{
+			Standard: &struct{}{},
+		},
+	}
+}
+
+// WithChainName sets the name of the chain the transaction is executed on, it is required.
+func (b *TransactionV1Builder[T]) WithChainName(chainName string) T {
+	b.chainName = chainName
+	return b.self
+}
+
+// WithTTL sets the duration during which the transaction is valid, DefaultTransactionTTL by default.
+func (b *TransactionV1Builder[T]) WithTTL(ttl time.Duration) T {
+	b.ttl = Duration(ttl)
+	return b.self
+}
+
+// WithTimestamp sets the creation time of the transaction, the builder creation time by default.
+func (b *TransactionV1Builder[T]) WithTimestamp(timestamp time.Time) T {
+	b.timestamp = Timestamp(timestamp)
+	return b.self
+}
+
+// WithPricingMode sets the PricingMode, Fixed with DefaultGasPriceTolerance by default.
+func (b *TransactionV1Builder[T]) WithPricingMode(pricingMode PricingMode) T {
+	b.pricingMode = pricingMode
+	return b.self
+}
+
+// WithScheduling sets the TransactionScheduling, Standard by default.
+func (b *TransactionV1Builder[T]) WithScheduling(scheduling TransactionScheduling) T {
+	b.scheduling = scheduling
+	return b.self
+}
+
+// WithInitiatorPublicKey sets the public key of the transaction initiator.
+func (b *TransactionV1Builder[T]) WithInitiatorPublicKey(publicKey keypair.PublicKey) T {
+	b.initiatorAddr = &InitiatorAddr{PublicKey: &publicKey}
+	return b.self
+}
+
+// WithInitiatorAccountHash sets the account hash of the transaction initiator.
+func (b *TransactionV1Builder[T]) WithInitiatorAccountHash(accountHash key.AccountHash) T {
+	b.initiatorAddr = &InitiatorAddr{AccountHash: &accountHash}
+	return b.self
+}
+
+func (b *TransactionV1Builder[T]) build(args *Args, target TransactionTarget, entryPoint TransactionEntryPoint) (*TransactionV1, error) {
+	if b.chainName == "" {
+		return nil, ErrMissingChainName
+	}
+	if b.initiatorAddr == nil {
+		return nil, ErrMissingInitiator
+	}
+
+	payload, err := NewTransactionV1Payload(
+		*b.initiatorAddr,
+		b.timestamp,
+		b.ttl,
+		b.chainName,
+		b.pricingMode,
+		NewNamedArgs(args),
+		target,
+		entryPoint,
+		b.scheduling,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return MakeTransactionV1(payload)
+}
+
+func requirePublicKeyArgument(name string, publicKey *keypair.PublicKey) error {
+	if publicKey == nil || len(publicKey.Bytes()) == 0 {
+		return fmt.Errorf("%w, argument: %s", ErrMissingTransactionArgument, name)
+	}
+	return nil
+}
+
+func requireAmountArgument(name string, amount *big.Int) error {
+	if amount == nil {
+		return fmt.Errorf("%w, argument: %s", ErrMissingTransactionArgument, name)
+	}
+	if amount.Sign() <= 0 {
+		return fmt.Errorf("%w, argument: %s, details: the amount should be positive", ErrInvalidTransactionArgument, name)
+	}
+	return nil
+}
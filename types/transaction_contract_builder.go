@@ -0,0 +1,207 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+)
+
+var ErrInvalidTransactionRuntime = errors.New("invalid transaction runtime")
+
+// runtimeParams are the runtime settings shared by the contract call and session builders, VmCasperV1 is used by default.
+type runtimeParams struct {
+	vmCasperV2       bool
+	transferredValue *uint64
+	seed             *key.Hash
+}
+
+func (p runtimeParams) runtime() (TransactionRuntime, error) {
+	if p.vmCasperV2 {
+		var transferredValue uint64
+		if p.transferredValue != nil {
+			transferredValue = *p.transferredValue
+		}
+		return NewVmCasperV2TransactionRuntime(transferredValue, p.seed), nil
+	}
+
+	if p.transferredValue != nil {
+		return TransactionRuntime{}, fmt.Errorf("%w, details: transferred value is supported by VmCasperV2 only", ErrInvalidTransactionRuntime)
+	}
+	if p.seed != nil {
+		return TransactionRuntime{}, fmt.Errorf("%w, details: seed is supported by VmCasperV2 only", ErrInvalidTransactionRuntime)
+	}
+	return NewVmCasperV1TransactionRuntime(), nil
+}
+
+func argsOrEmpty(args *Args) *Args {
+	if args == nil {
+		return &Args{}
+	}
+	return args
+}
+
+// ContractCallTransactionBuilder builds the TransactionV1 calling the entry point of a stored contract.
+type ContractCallTransactionBuilder struct {
+	TransactionV1Builder[*ContractCallTransactionBuilder]
+	runtimeParams
+	invocationTarget *TransactionInvocationTarget
+	entryPoint       string
+	args             *Args
+}
+
+func NewContractCallTransactionBuilder() *ContractCallTransactionBuilder {
+	builder := &ContractCallTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithContractHash targets the contract by its hash.
+func (b *ContractCallTransactionBuilder) WithContractHash(hash key.Hash) *ContractCallTransactionBuilder {
+	b.invocationTarget = &TransactionInvocationTarget{ByHash: &hash}
+	return b
+}
+
+// WithContractName targets the contract by the named key of the initiator.
+func (b *ContractCallTransactionBuilder) WithContractName(name string) *ContractCallTransactionBuilder {
+	b.invocationTarget = &TransactionInvocationTarget{ByName: &name}
+	return b
+}
+
+// WithPackageHash targets the contract package by its hash, the latest version is used if the version is nil.
+func (b *ContractCallTransactionBuilder) WithPackageHash(hash key.Hash, version *uint32) *ContractCallTransactionBuilder {
+	b.invocationTarget = &TransactionInvocationTarget{
+		ByPackageHash: &ByPackageHashInvocationTarget{Addr: hash, Version: version},
+	}
+	return b
+}
+
+// WithPackageName targets the contract package by the named key of the initiator, the latest version is used if the version is nil.
+func (b *ContractCallTransactionBuilder) WithPackageName(name string, version *uint32) *ContractCallTransactionBuilder {
+	b.invocationTarget = &TransactionInvocationTarget{
+		ByPackageName: &ByPackageNameInvocationTarget{Name: name, Version: version},
+	}
+	return b
+}
+
+// WithEntryPoint sets the name of the called entry point.
+func (b *ContractCallTransactionBuilder) WithEntryPoint(entryPoint string) *ContractCallTransactionBuilder {
+	b.entryPoint = entryPoint
+	return b
+}
+
+// WithArgs sets the arguments passed to the entry point.
+func (b *ContractCallTransactionBuilder) WithArgs(args *Args) *ContractCallTransactionBuilder {
+	b.args = args
+	return b
+}
+
+// WithVmCasperV2 executes the contract with the VmCasperV2 runtime.
+func (b *ContractCallTransactionBuilder) WithVmCasperV2() *ContractCallTransactionBuilder {
+	b.vmCasperV2 = true
+	return b
+}
+
+// WithTransferredValue sets the amount of motes transferred to the contract, it is supported by VmCasperV2 only.
+func (b *ContractCallTransactionBuilder) WithTransferredValue(transferredValue uint64) *ContractCallTransactionBuilder {
+	b.transferredValue = &transferredValue
+	return b
+}
+
+// WithSeed sets the seed of the VmCasperV2 runtime.
+func (b *ContractCallTransactionBuilder) WithSeed(seed key.Hash) *ContractCallTransactionBuilder {
+	b.seed = &seed
+	return b
+}
+
+func (b *ContractCallTransactionBuilder) Build() (*TransactionV1, error) {
+	if b.invocationTarget == nil {
+		return nil, fmt.Errorf("%w, details: contract is not set", ErrMissingTransactionArgument)
+	}
+	if b.entryPoint == "" {
+		return nil, fmt.Errorf("%w, details: entry point is not set", ErrMissingTransactionArgument)
+	}
+	runtime, err := b.runtime()
+	if err != nil {
+		return nil, err
+	}
+
+	target := TransactionTarget{
+		Stored: &StoredTarget{
+			ID:      *b.invocationTarget,
+			Runtime: runtime,
+		},
+	}
+	entryPoint := b.entryPoint
+	return b.build(argsOrEmpty(b.args), target, TransactionEntryPoint{Custom: &entryPoint})
+}
+
+// SessionTransactionBuilder builds the TransactionV1 executing the Wasm module, e.g. installing or upgrading a contract.
+type SessionTransactionBuilder struct {
+	TransactionV1Builder[*SessionTransactionBuilder]
+	runtimeParams
+	moduleBytes      []byte
+	isInstallUpgrade bool
+	args             *Args
+}
+
+func NewSessionTransactionBuilder() *SessionTransactionBuilder {
+	builder := &SessionTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithModuleBytes sets the compiled Wasm executed by the transaction.
+func (b *SessionTransactionBuilder) WithModuleBytes(moduleBytes []byte) *SessionTransactionBuilder {
+	b.moduleBytes = moduleBytes
+	return b
+}
+
+// WithInstallUpgrade marks the session as the installation or upgrade of a contract.
+func (b *SessionTransactionBuilder) WithInstallUpgrade(isInstallUpgrade bool) *SessionTransactionBuilder {
+	b.isInstallUpgrade = isInstallUpgrade
+	return b
+}
+
+// WithArgs sets the arguments passed to the session.
+func (b *SessionTransactionBuilder) WithArgs(args *Args) *SessionTransactionBuilder {
+	b.args = args
+	return b
+}
+
+// WithVmCasperV2 executes the session with the VmCasperV2 runtime.
+func (b *SessionTransactionBuilder) WithVmCasperV2() *SessionTransactionBuilder {
+	b.vmCasperV2 = true
+	return b
+}
+
+// WithTransferredValue sets the amount of motes transferred to the session, it is supported by VmCasperV2 only.
+func (b *SessionTransactionBuilder) WithTransferredValue(transferredValue uint64) *SessionTransactionBuilder {
+	b.transferredValue = &transferredValue
+	return b
+}
+
+// WithSeed sets the seed used to derive the address of the installed contract, it is supported by VmCasperV2 only.
+func (b *SessionTransactionBuilder) WithSeed(seed key.Hash) *SessionTransactionBuilder {
+	b.seed = &seed
+	return b
+}
+
+func (b *SessionTransactionBuilder) Build() (*TransactionV1, error) {
+	if len(b.moduleBytes) == 0 {
+		return nil, fmt.Errorf("%w, details: module bytes are not set", ErrMissingTransactionArgument)
+	}
+	runtime, err := b.runtime()
+	if err != nil {
+		return nil, err
+	}
+
+	target := TransactionTarget{
+		Session: &SessionTarget{
+			ModuleBytes:      b.moduleBytes,
+			Runtime:          runtime,
+			IsInstallUpgrade: b.isInstallUpgrade,
+		},
+	}
+	return b.build(argsOrEmpty(b.args), target, TransactionEntryPoint{Call: &struct{}{}})
+}
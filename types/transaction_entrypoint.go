@@ -254,6 +254,70 @@ func (t *TransactionEntryPoint) Bytes() ([]byte, error) {
 	return builder.BinaryPayloadBytes()
 }
 
+type TransactionEntryPointFromBytesDecoder struct{}
+
+func (d *TransactionEntryPointFromBytesDecoder) FromBytes(source []byte) (*TransactionEntryPoint, []byte, error) {
+	window, remainder, err := startCallTable(2, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = window.VerifyIndex(TagFieldIndex); err != nil {
+		return nil, nil, err
+	}
+	tag, nextWindow, err := serialization.DeserializeAndMaybeNext[uint8](window, &encoding.U8FromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tag == TransactionEntryPointCustomTag {
+		if err = expectField(nextWindow, CustomCustomIndex); err != nil {
+			return nil, nil, err
+		}
+		custom, finalWindow, err := serialization.DeserializeAndMaybeNext[string](nextWindow, &encoding.StringFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if finalWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+		return &TransactionEntryPoint{Custom: &custom}, remainder, nil
+	}
+
+	if nextWindow != nil {
+		return nil, nil, serialization.ErrFormatting
+	}
+
+	var entryPoint TransactionEntryPoint
+	switch tag {
+	case TransactionEntryPointCallTag:
+		entryPoint.Call = &struct{}{}
+	case TransactionEntryPointTransferTag:
+		entryPoint.Transfer = &struct{}{}
+	case TransactionEntryPointAddBidTag:
+		entryPoint.AddBid = &struct{}{}
+	case TransactionEntryPointWithdrawBidTag:
+		entryPoint.WithdrawBid = &struct{}{}
+	case TransactionEntryPointDelegateTag:
+		entryPoint.Delegate = &struct{}{}
+	case TransactionEntryPointUndelegateTag:
+		entryPoint.Undelegate = &struct{}{}
+	case TransactionEntryPointRedelegateTag:
+		entryPoint.Redelegate = &struct{}{}
+	case TransactionEntryPointActivateBidTag:
+		entryPoint.ActivateBid = &struct{}{}
+	case TransactionEntryPointChangeBidPublicKeyTag:
+		entryPoint.ChangeBidPublicKey = &struct{}{}
+	case TransactionEntryPointAddReservationsTag:
+		entryPoint.AddReservations = &struct{}{}
+	case TransactionEntryCancelReservationsTag:
+		entryPoint.CancelReservations = &struct{}{}
+	default:
+		return nil, nil, serialization.ErrFormatting
+	}
+	return &entryPoint, remainder, nil
+}
+
 func (t *TransactionEntryPoint) serializedFieldLengths() []int {
 	switch {
 	case t.Custom != nil:
@@ -152,6 +152,117 @@ func (t *TransactionInvocationTarget) Bytes() ([]byte, error) {
 	return builder.BinaryPayloadBytes()
 }
 
+type TransactionInvocationTargetFromBytesDecoder struct{}
+
+func (d *TransactionInvocationTargetFromBytesDecoder) FromBytes(source []byte) (*TransactionInvocationTarget, []byte, error) {
+	window, remainder, err := startCallTable(4, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = window.VerifyIndex(TagFieldIndex); err != nil {
+		return nil, nil, err
+	}
+	tag, nextWindow, err := serialization.DeserializeAndMaybeNext[uint8](window, &encoding.U8FromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch tag {
+	case ByHashVariant:
+		if err = expectField(nextWindow, ByHashHashIndex); err != nil {
+			return nil, nil, err
+		}
+		hash, finalWindow, err := serialization.DeserializeAndMaybeNext[key.Hash](nextWindow, &hashFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if finalWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+		return &TransactionInvocationTarget{ByHash: &hash}, remainder, nil
+	case ByNameVariant:
+		if err = expectField(nextWindow, ByNameNameIndex); err != nil {
+			return nil, nil, err
+		}
+		name, finalWindow, err := serialization.DeserializeAndMaybeNext[string](nextWindow, &encoding.StringFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if finalWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+		return &TransactionInvocationTarget{ByName: &name}, remainder, nil
+	case ByPackageHashVariant:
+		if err = expectField(nextWindow, ByPackageHashAddrIndex); err != nil {
+			return nil, nil, err
+		}
+		addr, nextWindow, err := serialization.DeserializeAndMaybeNext[key.Hash](nextWindow, &hashFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+		version, protocolVersionMajor, err := decodePackageVersions(nextWindow, ByPackageHashVersionIndex, ByPackageHashProtocolVersionMajorIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &TransactionInvocationTarget{
+			ByPackageHash: &ByPackageHashInvocationTarget{
+				Addr:                 addr,
+				Version:              version,
+				ProtocolVersionMajor: protocolVersionMajor,
+			},
+		}, remainder, nil
+	case ByPackageNameVariant:
+		if err = expectField(nextWindow, ByPackageNameNameIndex); err != nil {
+			return nil, nil, err
+		}
+		name, nextWindow, err := serialization.DeserializeAndMaybeNext[string](nextWindow, &encoding.StringFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+		version, protocolVersionMajor, err := decodePackageVersions(nextWindow, ByPackageNameVersionIndex, ByPackageNameProtocolVersionMajorIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &TransactionInvocationTarget{
+			ByPackageName: &ByPackageNameInvocationTarget{
+				Name:                 name,
+				Version:              version,
+				ProtocolVersionMajor: protocolVersionMajor,
+			},
+		}, remainder, nil
+	default:
+		return nil, nil, serialization.ErrFormatting
+	}
+}
+
+// decodePackageVersions decodes the optional version and protocol version major, the trailing fields of the package targets.
+func decodePackageVersions(window *serialization.CallTableFieldsIterator, versionIndex, protocolVersionMajorIndex uint16) (*uint32, *uint32, error) {
+	optionDecoder := &encoding.OptionFromBytesDecoder[uint32, *encoding.U32FromBytesDecoder]{
+		Decoder: encoding.NewU32FromBytesDecoder(),
+	}
+
+	if err := expectField(window, versionIndex); err != nil {
+		return nil, nil, err
+	}
+	version, window, err := serialization.DeserializeAndMaybeNext[encoding.Option[uint32]](window, optionDecoder)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = expectField(window, protocolVersionMajorIndex); err != nil {
+		return nil, nil, err
+	}
+	protocolVersionMajor, window, err := serialization.DeserializeAndMaybeNext[encoding.Option[uint32]](window, optionDecoder)
+	if err != nil {
+		return nil, nil, err
+	}
+	if window != nil {
+		return nil, nil, serialization.ErrFormatting
+	}
+	return version.Some, protocolVersionMajor.Some, nil
+}
+
 func (t *TransactionInvocationTarget) SerializedLength() int {
 	envelope := serialization.CallTableSerializationEnvelope{}
 	return envelope.EstimateSize(t.serializedFieldLengths())
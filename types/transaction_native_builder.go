@@ -0,0 +1,541 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue"
+	"github.com/make-software/casper-go-sdk/v2/types/clvalue/cltype"
+	"github.com/make-software/casper-go-sdk/v2/types/key"
+	"github.com/make-software/casper-go-sdk/v2/types/keypair"
+)
+
+// MaxDelegationRate is the denominator of the delegation rate, the rate is set in percents.
+const MaxDelegationRate uint8 = 100
+
+// Names of the arguments of the native entry points.
+const (
+	NativeArgSource                  = "source"
+	NativeArgTarget                  = "target"
+	NativeArgAmount                  = "amount"
+	NativeArgID                      = "id"
+	NativeArgPublicKey               = "public_key"
+	NativeArgNewPublicKey            = "new_public_key"
+	NativeArgDelegationRate          = "delegation_rate"
+	NativeArgMinimumDelegationAmount = "minimum_delegation_amount"
+	NativeArgMaximumDelegationAmount = "maximum_delegation_amount"
+	NativeArgReservedSlots           = "reserved_slots"
+	NativeArgDelegator               = "delegator"
+	NativeArgValidator               = "validator"
+	NativeArgNewValidator            = "new_validator"
+	NativeArgReservations            = "reservations"
+	NativeArgDelegators              = "delegators"
+)
+
+func nativeTarget() TransactionTarget {
+	return TransactionTarget{Native: &struct{}{}}
+}
+
+// TransferTransactionBuilder builds the TransactionV1 calling the `transfer` native entry point.
+type TransferTransactionBuilder struct {
+	TransactionV1Builder[*TransferTransactionBuilder]
+	source *key.URef
+	target *clvalue.CLValue
+	amount *big.Int
+	id     *uint64
+}
+
+func NewTransferTransactionBuilder() *TransferTransactionBuilder {
+	builder := &TransferTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithSource sets the purse the motes are transferred from, the main purse of the initiator is used by default.
+func (b *TransferTransactionBuilder) WithSource(source key.URef) *TransferTransactionBuilder {
+	b.source = &source
+	return b
+}
+
+// WithTargetPublicKey sets the account receiving the motes by its public key.
+func (b *TransferTransactionBuilder) WithTargetPublicKey(target keypair.PublicKey) *TransferTransactionBuilder {
+	value := clvalue.NewCLPublicKey(target)
+	b.target = &value
+	return b
+}
+
+// WithTargetAccountHash sets the account receiving the motes by its account hash.
+func (b *TransferTransactionBuilder) WithTargetAccountHash(target key.AccountHash) *TransferTransactionBuilder {
+	value := clvalue.NewCLByteArray(target.Bytes())
+	b.target = &value
+	return b
+}
+
+// WithTargetPurse sets the purse receiving the motes.
+func (b *TransferTransactionBuilder) WithTargetPurse(target key.URef) *TransferTransactionBuilder {
+	value := clvalue.NewCLUref(target)
+	b.target = &value
+	return b
+}
+
+// WithAmount sets the amount of motes to transfer.
+func (b *TransferTransactionBuilder) WithAmount(amount *big.Int) *TransferTransactionBuilder {
+	b.amount = amount
+	return b
+}
+
+// WithID sets the user-defined identifier of the transfer.
+func (b *TransferTransactionBuilder) WithID(id uint64) *TransferTransactionBuilder {
+	b.id = &id
+	return b
+}
+
+func (b *TransferTransactionBuilder) Build() (*TransactionV1, error) {
+	if b.target == nil {
+		return nil, fmt.Errorf("%w, argument: %s", ErrMissingTransactionArgument, NativeArgTarget)
+	}
+	if err := requireAmountArgument(NativeArgAmount, b.amount); err != nil {
+		return nil, err
+	}
+
+	args := &Args{}
+	if b.source != nil {
+		args.AddArgument(NativeArgSource, clvalue.NewCLUref(*b.source))
+	}
+	args.AddArgument(NativeArgTarget, *b.target).
+		AddArgument(NativeArgAmount, *clvalue.NewCLUInt512(b.amount))
+	if b.id != nil {
+		args.AddArgument(NativeArgID, clvalue.NewCLOption(*clvalue.NewCLUInt64(*b.id)))
+	}
+	return b.build(args, nativeTarget(), TransactionEntryPoint{Transfer: &struct{}{}})
+}
+
+// AddBidTransactionBuilder builds the TransactionV1 calling the `add_bid` native entry point.
+type AddBidTransactionBuilder struct {
+	TransactionV1Builder[*AddBidTransactionBuilder]
+	publicKey               *keypair.PublicKey
+	delegationRate          *uint8
+	amount                  *big.Int
+	minimumDelegationAmount *uint64
+	maximumDelegationAmount *uint64
+	reservedSlots           *uint32
+}
+
+func NewAddBidTransactionBuilder() *AddBidTransactionBuilder {
+	builder := &AddBidTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithPublicKey sets the public key of the validator.
+func (b *AddBidTransactionBuilder) WithPublicKey(publicKey keypair.PublicKey) *AddBidTransactionBuilder {
+	b.publicKey = &publicKey
+	return b
+}
+
+// WithDelegationRate sets the delegation rate in percents.
+func (b *AddBidTransactionBuilder) WithDelegationRate(delegationRate uint8) *AddBidTransactionBuilder {
+	b.delegationRate = &delegationRate
+	return b
+}
+
+// WithAmount sets the amount of motes added to the bid.
+func (b *AddBidTransactionBuilder) WithAmount(amount *big.Int) *AddBidTransactionBuilder {
+	b.amount = amount
+	return b
+}
+
+// WithDelegationAmountLimits sets the minimum and maximum amount of motes accepted from a delegator.
+func (b *AddBidTransactionBuilder) WithDelegationAmountLimits(minimum, maximum uint64) *AddBidTransactionBuilder {
+	b.minimumDelegationAmount = &minimum
+	b.maximumDelegationAmount = &maximum
+	return b
+}
+
+// WithReservedSlots sets the number of delegator slots reserved by the validator.
+func (b *AddBidTransactionBuilder) WithReservedSlots(reservedSlots uint32) *AddBidTransactionBuilder {
+	b.reservedSlots = &reservedSlots
+	return b
+}
+
+func (b *AddBidTransactionBuilder) Build() (*TransactionV1, error) {
+	if err := requirePublicKeyArgument(NativeArgPublicKey, b.publicKey); err != nil {
+		return nil, err
+	}
+	if b.delegationRate == nil {
+		return nil, fmt.Errorf("%w, argument: %s", ErrMissingTransactionArgument, NativeArgDelegationRate)
+	}
+	if *b.delegationRate > MaxDelegationRate {
+		return nil, fmt.Errorf("%w, argument: %s, details: the rate should not exceed %d", ErrInvalidTransactionArgument, NativeArgDelegationRate, MaxDelegationRate)
+	}
+	if err := requireAmountArgument(NativeArgAmount, b.amount); err != nil {
+		return nil, err
+	}
+	if b.minimumDelegationAmount != nil && *b.minimumDelegationAmount > *b.maximumDelegationAmount {
+		return nil, fmt.Errorf("%w, argument: %s, details: the minimum is greater than the maximum", ErrInvalidTransactionArgument, NativeArgMinimumDelegationAmount)
+	}
+
+	args := &Args{}
+	args.AddArgument(NativeArgPublicKey, clvalue.NewCLPublicKey(*b.publicKey)).
+		AddArgument(NativeArgDelegationRate, *clvalue.NewCLUint8(*b.delegationRate)).
+		AddArgument(NativeArgAmount, *clvalue.NewCLUInt512(b.amount))
+	if b.minimumDelegationAmount != nil {
+		args.AddArgument(NativeArgMinimumDelegationAmount, *clvalue.NewCLUInt64(*b.minimumDelegationAmount)).
+			AddArgument(NativeArgMaximumDelegationAmount, *clvalue.NewCLUInt64(*b.maximumDelegationAmount))
+	}
+	if b.reservedSlots != nil {
+		args.AddArgument(NativeArgReservedSlots, *clvalue.NewCLUInt32(*b.reservedSlots))
+	}
+	return b.build(args, nativeTarget(), TransactionEntryPoint{AddBid: &struct{}{}})
+}
+
+// WithdrawBidTransactionBuilder builds the TransactionV1 calling the `withdraw_bid` native entry point.
+type WithdrawBidTransactionBuilder struct {
+	TransactionV1Builder[*WithdrawBidTransactionBuilder]
+	publicKey *keypair.PublicKey
+	amount    *big.Int
+}
+
+func NewWithdrawBidTransactionBuilder() *WithdrawBidTransactionBuilder {
+	builder := &WithdrawBidTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithPublicKey sets the public key of the validator.
+func (b *WithdrawBidTransactionBuilder) WithPublicKey(publicKey keypair.PublicKey) *WithdrawBidTransactionBuilder {
+	b.publicKey = &publicKey
+	return b
+}
+
+// WithAmount sets the amount of motes withdrawn from the bid.
+func (b *WithdrawBidTransactionBuilder) WithAmount(amount *big.Int) *WithdrawBidTransactionBuilder {
+	b.amount = amount
+	return b
+}
+
+func (b *WithdrawBidTransactionBuilder) Build() (*TransactionV1, error) {
+	if err := requirePublicKeyArgument(NativeArgPublicKey, b.publicKey); err != nil {
+		return nil, err
+	}
+	if err := requireAmountArgument(NativeArgAmount, b.amount); err != nil {
+		return nil, err
+	}
+
+	args := &Args{}
+	args.AddArgument(NativeArgPublicKey, clvalue.NewCLPublicKey(*b.publicKey)).
+		AddArgument(NativeArgAmount, *clvalue.NewCLUInt512(b.amount))
+	return b.build(args, nativeTarget(), TransactionEntryPoint{WithdrawBid: &struct{}{}})
+}
+
+// delegationArgs are the arguments shared by the `delegate`, `undelegate` and `redelegate` native entry points.
+type delegationArgs struct {
+	delegator *keypair.PublicKey
+	validator *keypair.PublicKey
+	amount    *big.Int
+}
+
+func (d delegationArgs) args() (*Args, error) {
+	if err := requirePublicKeyArgument(NativeArgDelegator, d.delegator); err != nil {
+		return nil, err
+	}
+	if err := requirePublicKeyArgument(NativeArgValidator, d.validator); err != nil {
+		return nil, err
+	}
+	if err := requireAmountArgument(NativeArgAmount, d.amount); err != nil {
+		return nil, err
+	}
+
+	args := &Args{}
+	args.AddArgument(NativeArgDelegator, clvalue.NewCLPublicKey(*d.delegator)).
+		AddArgument(NativeArgValidator, clvalue.NewCLPublicKey(*d.validator)).
+		AddArgument(NativeArgAmount, *clvalue.NewCLUInt512(d.amount))
+	return args, nil
+}
+
+// DelegateTransactionBuilder builds the TransactionV1 calling the `delegate` native entry point.
+type DelegateTransactionBuilder struct {
+	TransactionV1Builder[*DelegateTransactionBuilder]
+	delegationArgs
+}
+
+func NewDelegateTransactionBuilder() *DelegateTransactionBuilder {
+	builder := &DelegateTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithDelegator sets the public key of the delegator.
+func (b *DelegateTransactionBuilder) WithDelegator(delegator keypair.PublicKey) *DelegateTransactionBuilder {
+	b.delegator = &delegator
+	return b
+}
+
+// WithValidator sets the public key of the validator the stake is delegated to.
+func (b *DelegateTransactionBuilder) WithValidator(validator keypair.PublicKey) *DelegateTransactionBuilder {
+	b.validator = &validator
+	return b
+}
+
+// WithAmount sets the amount of motes to delegate.
+func (b *DelegateTransactionBuilder) WithAmount(amount *big.Int) *DelegateTransactionBuilder {
+	b.amount = amount
+	return b
+}
+
+func (b *DelegateTransactionBuilder) Build() (*TransactionV1, error) {
+	args, err := b.args()
+	if err != nil {
+		return nil, err
+	}
+	return b.build(args, nativeTarget(), TransactionEntryPoint{Delegate: &struct{}{}})
+}
+
+// UndelegateTransactionBuilder builds the TransactionV1 calling the `undelegate` native entry point.
+type UndelegateTransactionBuilder struct {
+	TransactionV1Builder[*UndelegateTransactionBuilder]
+	delegationArgs
+}
+
+func NewUndelegateTransactionBuilder() *UndelegateTransactionBuilder {
+	builder := &UndelegateTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithDelegator sets the public key of the delegator.
+func (b *UndelegateTransactionBuilder) WithDelegator(delegator keypair.PublicKey) *UndelegateTransactionBuilder {
+	b.delegator = &delegator
+	return b
+}
+
+// WithValidator sets the public key of the validator the stake is undelegated from.
+func (b *UndelegateTransactionBuilder) WithValidator(validator keypair.PublicKey) *UndelegateTransactionBuilder {
+	b.validator = &validator
+	return b
+}
+
+// WithAmount sets the amount of motes to undelegate.
+func (b *UndelegateTransactionBuilder) WithAmount(amount *big.Int) *UndelegateTransactionBuilder {
+	b.amount = amount
+	return b
+}
+
+func (b *UndelegateTransactionBuilder) Build() (*TransactionV1, error) {
+	args, err := b.args()
+	if err != nil {
+		return nil, err
+	}
+	return b.build(args, nativeTarget(), TransactionEntryPoint{Undelegate: &struct{}{}})
+}
+
+// RedelegateTransactionBuilder builds the TransactionV1 calling the `redelegate` native entry point.
+type RedelegateTransactionBuilder struct {
+	TransactionV1Builder[*RedelegateTransactionBuilder]
+	delegationArgs
+	newValidator *keypair.PublicKey
+}
+
+func NewRedelegateTransactionBuilder() *RedelegateTransactionBuilder {
+	builder := &RedelegateTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithDelegator sets the public key of the delegator.
+func (b *RedelegateTransactionBuilder) WithDelegator(delegator keypair.PublicKey) *RedelegateTransactionBuilder {
+	b.delegator = &delegator
+	return b
+}
+
+// WithValidator sets the public key of the validator the stake is undelegated from.
+func (b *RedelegateTransactionBuilder) WithValidator(validator keypair.PublicKey) *RedelegateTransactionBuilder {
+	b.validator = &validator
+	return b
+}
+
+// WithNewValidator sets the public key of the validator the stake is delegated to after the unbonding delay.
+func (b *RedelegateTransactionBuilder) WithNewValidator(newValidator keypair.PublicKey) *RedelegateTransactionBuilder {
+	b.newValidator = &newValidator
+	return b
+}
+
+// WithAmount sets the amount of motes to redelegate.
+func (b *RedelegateTransactionBuilder) WithAmount(amount *big.Int) *RedelegateTransactionBuilder {
+	b.amount = amount
+	return b
+}
+
+func (b *RedelegateTransactionBuilder) Build() (*TransactionV1, error) {
+	args, err := b.args()
+	if err != nil {
+		return nil, err
+	}
+	if err = requirePublicKeyArgument(NativeArgNewValidator, b.newValidator); err != nil {
+		return nil, err
+	}
+	if b.newValidator.Equals(*b.validator) {
+		return nil, fmt.Errorf("%w, argument: %s, details: the new validator is the same as the current one", ErrInvalidTransactionArgument, NativeArgNewValidator)
+	}
+
+	args.AddArgument(NativeArgNewValidator, clvalue.NewCLPublicKey(*b.newValidator))
+	return b.build(args, nativeTarget(), TransactionEntryPoint{Redelegate: &struct{}{}})
+}
+
+// ActivateBidTransactionBuilder builds the TransactionV1 calling the `activate_bid` native entry point.
+type ActivateBidTransactionBuilder struct {
+	TransactionV1Builder[*ActivateBidTransactionBuilder]
+	validator *keypair.PublicKey
+}
+
+func NewActivateBidTransactionBuilder() *ActivateBidTransactionBuilder {
+	builder := &ActivateBidTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithValidator sets the public key of the validator which bid is reactivated.
+func (b *ActivateBidTransactionBuilder) WithValidator(validator keypair.PublicKey) *ActivateBidTransactionBuilder {
+	b.validator = &validator
+	return b
+}
+
+func (b *ActivateBidTransactionBuilder) Build() (*TransactionV1, error) {
+	if err := requirePublicKeyArgument(NativeArgValidator, b.validator); err != nil {
+		return nil, err
+	}
+
+	args := &Args{}
+	args.AddArgument(NativeArgValidator, clvalue.NewCLPublicKey(*b.validator))
+	return b.build(args, nativeTarget(), TransactionEntryPoint{ActivateBid: &struct{}{}})
+}
+
+// ChangeBidPublicKeyTransactionBuilder builds the TransactionV1 calling the `change_bid_public_key` native entry point.
+type ChangeBidPublicKeyTransactionBuilder struct {
+	TransactionV1Builder[*ChangeBidPublicKeyTransactionBuilder]
+	publicKey    *keypair.PublicKey
+	newPublicKey *keypair.PublicKey
+}
+
+func NewChangeBidPublicKeyTransactionBuilder() *ChangeBidPublicKeyTransactionBuilder {
+	builder := &ChangeBidPublicKeyTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithPublicKey sets the current public key of the bid.
+func (b *ChangeBidPublicKeyTransactionBuilder) WithPublicKey(publicKey keypair.PublicKey) *ChangeBidPublicKeyTransactionBuilder {
+	b.publicKey = &publicKey
+	return b
+}
+
+// WithNewPublicKey sets the public key the bid is moved to.
+func (b *ChangeBidPublicKeyTransactionBuilder) WithNewPublicKey(newPublicKey keypair.PublicKey) *ChangeBidPublicKeyTransactionBuilder {
+	b.newPublicKey = &newPublicKey
+	return b
+}
+
+func (b *ChangeBidPublicKeyTransactionBuilder) Build() (*TransactionV1, error) {
+	if err := requirePublicKeyArgument(NativeArgPublicKey, b.publicKey); err != nil {
+		return nil, err
+	}
+	if err := requirePublicKeyArgument(NativeArgNewPublicKey, b.newPublicKey); err != nil {
+		return nil, err
+	}
+
+	args := &Args{}
+	args.AddArgument(NativeArgPublicKey, clvalue.NewCLPublicKey(*b.publicKey)).
+		AddArgument(NativeArgNewPublicKey, clvalue.NewCLPublicKey(*b.newPublicKey))
+	return b.build(args, nativeTarget(), TransactionEntryPoint{ChangeBidPublicKey: &struct{}{}})
+}
+
+// AddReservationsTransactionBuilder builds the TransactionV1 calling the `add_reservations` native entry point.
+type AddReservationsTransactionBuilder struct {
+	TransactionV1Builder[*AddReservationsTransactionBuilder]
+	reservations []Reservation
+}
+
+func NewAddReservationsTransactionBuilder() *AddReservationsTransactionBuilder {
+	builder := &AddReservationsTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithReservations appends the delegator slots reserved by the validator.
+func (b *AddReservationsTransactionBuilder) WithReservations(reservations ...Reservation) *AddReservationsTransactionBuilder {
+	b.reservations = append(b.reservations, reservations...)
+	return b
+}
+
+func (b *AddReservationsTransactionBuilder) Build() (*TransactionV1, error) {
+	if len(b.reservations) == 0 {
+		return nil, fmt.Errorf("%w, argument: %s", ErrMissingTransactionArgument, NativeArgReservations)
+	}
+
+	list := clvalue.NewCLList(cltype.Any)
+	for _, reservation := range b.reservations {
+		if err := requirePublicKeyArgument(NativeArgReservations, &reservation.ValidatorPublicKey); err != nil {
+			return nil, err
+		}
+		if reservation.DelegationRate > MaxDelegationRate {
+			return nil, fmt.Errorf("%w, argument: %s, details: the rate should not exceed %d", ErrInvalidTransactionArgument, NativeArgReservations, MaxDelegationRate)
+		}
+		reservationBytes, err := reservation.Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("%w, argument: %s, details: %s", ErrInvalidTransactionArgument, NativeArgReservations, err.Error())
+		}
+		list.List.Append(clvalue.NewCLAny(reservationBytes))
+	}
+
+	args := &Args{}
+	args.AddArgument(NativeArgReservations, list)
+	return b.build(args, nativeTarget(), TransactionEntryPoint{AddReservations: &struct{}{}})
+}
+
+// CancelReservationsTransactionBuilder builds the TransactionV1 calling the `cancel_reservations` native entry point.
+type CancelReservationsTransactionBuilder struct {
+	TransactionV1Builder[*CancelReservationsTransactionBuilder]
+	validator  *keypair.PublicKey
+	delegators []DelegatorKind
+}
+
+func NewCancelReservationsTransactionBuilder() *CancelReservationsTransactionBuilder {
+	builder := &CancelReservationsTransactionBuilder{}
+	builder.TransactionV1Builder = newTransactionV1Builder(builder)
+	return builder
+}
+
+// WithValidator sets the public key of the validator cancelling the reservations.
+func (b *CancelReservationsTransactionBuilder) WithValidator(validator keypair.PublicKey) *CancelReservationsTransactionBuilder {
+	b.validator = &validator
+	return b
+}
+
+// WithDelegators appends the delegators removed from the validator's reserve list.
+func (b *CancelReservationsTransactionBuilder) WithDelegators(delegators ...DelegatorKind) *CancelReservationsTransactionBuilder {
+	b.delegators = append(b.delegators, delegators...)
+	return b
+}
+
+func (b *CancelReservationsTransactionBuilder) Build() (*TransactionV1, error) {
+	if err := requirePublicKeyArgument(NativeArgValidator, b.validator); err != nil {
+		return nil, err
+	}
+	if len(b.delegators) == 0 {
+		return nil, fmt.Errorf("%w, argument: %s", ErrMissingTransactionArgument, NativeArgDelegators)
+	}
+
+	list := clvalue.NewCLList(cltype.Any)
+	for _, delegator := range b.delegators {
+		delegatorBytes, err := delegator.Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("%w, argument: %s, details: %s", ErrInvalidTransactionArgument, NativeArgDelegators, err.Error())
+		}
+		list.List.Append(clvalue.NewCLAny(delegatorBytes))
+	}
+
+	args := &Args{}
+	args.AddArgument(NativeArgValidator, clvalue.NewCLPublicKey(*b.validator)).
+		AddArgument(NativeArgDelegators, list)
+	return b.build(args, nativeTarget(), TransactionEntryPoint{CancelReservations: &struct{}{}})
+}
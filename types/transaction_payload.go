@@ -2,7 +2,9 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
+	"github.com/make-software/casper-go-sdk/v2/types/key"
 	"github.com/make-software/casper-go-sdk/v2/types/serialization"
 	"github.com/make-software/casper-go-sdk/v2/types/serialization/encoding"
 )
@@ -237,3 +239,191 @@ func (d TransactionV1Payload) serializedFieldLengths() []int {
 		d.Fields.SerializedLength(),
 	}
 }
+
+// NamedArgsVariantTag is the leading byte of the arguments serialized as the named list.
+const NamedArgsVariantTag byte = 0
+
+type NamedArgsFromBytesDecoder struct{}
+
+func (d *NamedArgsFromBytesDecoder) FromBytes(source []byte) (NamedArgs, []byte, error) {
+	if len(source) == 0 {
+		return NamedArgs{}, nil, serialization.ErrEarlyEndOfStream
+	}
+	if source[0] != NamedArgsVariantTag {
+		return NamedArgs{}, nil, fmt.Errorf("%w, unsupported args variant %d", serialization.ErrFormatting, source[0])
+	}
+	args, remainder, err := (&ArgsFromBytesDecoder{}).FromBytes(source[1:])
+	if err != nil {
+		return NamedArgs{}, nil, err
+	}
+	return NewNamedArgs(&args), remainder, nil
+}
+
+// TransactionV1FieldsFromBytesDecoder decodes the TransactionV1Fields serialized as the map of the field keys to their bytes.
+type TransactionV1FieldsFromBytesDecoder struct{}
+
+func (d *TransactionV1FieldsFromBytesDecoder) FromBytes(source []byte) (*TransactionV1Fields, []byte, error) {
+	count, remainder, err := encoding.NewU32FromBytesDecoder().FromBytes(source)
+	if err != nil {
+		return nil, nil, decodingError("count", err)
+	}
+
+	fields := make(map[uint16][]byte, count)
+	for i := uint32(0); i < count; i++ {
+		var fieldKey uint16
+		if fieldKey, remainder, err = (&encoding.U16FromBytesDecoder{}).FromBytes(remainder); err != nil {
+			return nil, nil, decodingError(fmt.Sprintf("entries[%d].key", i), err)
+		}
+		var value []byte
+		if value, remainder, err = (&encoding.BytesFromBytesDecoder{}).FromBytes(remainder); err != nil {
+			return nil, nil, decodingError(fmt.Sprintf("entries[%d].value", i), err)
+		}
+		if _, ok := fields[fieldKey]; ok {
+			return nil, nil, decodingError(fmt.Sprintf("entries[%d].key", i), fmt.Errorf("%w, duplicated key %d", serialization.ErrFormatting, fieldKey))
+		}
+		fields[fieldKey] = value
+	}
+
+	namedArgs, err := decodeTransactionField[NamedArgs](fields, ArgsMapKey, "args", &NamedArgsFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := decodeTransactionField[*TransactionTarget](fields, TargetMapKey, "target", &TransactionTargetFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+	entryPoint, err := decodeTransactionField[*TransactionEntryPoint](fields, EntryPointMapKey, "entry_point", &TransactionEntryPointFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+	scheduling, err := decodeTransactionField[*TransactionScheduling](fields, SchedulingMapKey, "scheduling", &TransactionSchedulingFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(fields) != 4 {
+		return nil, nil, decodingError("count", fmt.Errorf("%w, unexpected number of fields %d", serialization.ErrFormatting, len(fields)))
+	}
+
+	result, err := NewTransactionV1Fields(namedArgs, *target, *entryPoint, *scheduling)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &result, remainder, nil
+}
+
+// decodeTransactionField decodes the value of the TransactionV1Fields entry, the value should be consumed completely.
+func decodeTransactionField[T any](fields map[uint16][]byte, fieldKey uint16, name string, decoder encoding.FromBytes[T]) (T, error) {
+	var zero T
+	data, ok := fields[fieldKey]
+	if !ok {
+		return zero, decodingError(name, fmt.Errorf("%w, field is missing", serialization.ErrFormatting))
+	}
+	value, remainder, err := decoder.FromBytes(data)
+	if err != nil {
+		return zero, decodingError(name, err)
+	}
+	if len(remainder) != 0 {
+		return zero, decodingError(name, ErrTrailingBytes)
+	}
+	return value, nil
+}
+
+// TransactionV1PayloadFromBytesDecoder decodes the TransactionV1Payload from the call table representation produced by Bytes.
+type TransactionV1PayloadFromBytesDecoder struct{}
+
+func (d *TransactionV1PayloadFromBytesDecoder) FromBytes(source []byte) (*TransactionV1Payload, []byte, error) {
+	window, remainder, err := startCallTable(6, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = expectField(window, InitiatorAddrFieldIndex); err != nil {
+		return nil, nil, decodingError("initiator_addr", err)
+	}
+	initiatorAddr, window, err := serialization.DeserializeAndMaybeNext[*InitiatorAddr](window, &InitiatorAddrFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, decodingError("initiator_addr", err)
+	}
+
+	if err = expectField(window, TimestampFieldIndex); err != nil {
+		return nil, nil, decodingError("timestamp", err)
+	}
+	timestamp, window, err := serialization.DeserializeAndMaybeNext[*Timestamp](window, &TimestampFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, decodingError("timestamp", err)
+	}
+
+	if err = expectField(window, TtlFieldIndex); err != nil {
+		return nil, nil, decodingError("ttl", err)
+	}
+	ttl, window, err := serialization.DeserializeAndMaybeNext[*Duration](window, &DurationFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, decodingError("ttl", err)
+	}
+
+	if err = expectField(window, ChainNameFieldIndex); err != nil {
+		return nil, nil, decodingError("chain_name", err)
+	}
+	chainName, window, err := serialization.DeserializeAndMaybeNext[string](window, &encoding.StringFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, decodingError("chain_name", err)
+	}
+
+	if err = expectField(window, PricingModeFieldIndex); err != nil {
+		return nil, nil, decodingError("pricing_mode", err)
+	}
+	pricingMode, window, err := serialization.DeserializeAndMaybeNext[*PricingMode](window, &PricingModeFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, decodingError("pricing_mode", err)
+	}
+
+	if err = expectField(window, FieldsFieldIndex); err != nil {
+		return nil, nil, decodingError("fields", err)
+	}
+	fields, window, err := serialization.DeserializeAndMaybeNext[*TransactionV1Fields](window, &TransactionV1FieldsFromBytesDecoder{})
+	if err != nil {
+		return nil, nil, decodingError("fields", err)
+	}
+	if window != nil {
+		return nil, nil, decodingError("fields", ErrTrailingBytes)
+	}
+
+	return &TransactionV1Payload{
+		InitiatorAddr: *initiatorAddr,
+		Timestamp:     *timestamp,
+		TTL:           *ttl,
+		ChainName:     chainName,
+		PricingMode:   *pricingMode,
+		Fields:        *fields,
+	}, remainder, nil
+}
+
+// startCallTable decodes the call table envelope and returns the iterator positioned at its first field.
+func startCallTable(maxExpectedFields uint32, source []byte) (*serialization.CallTableFieldsIterator, []byte, error) {
+	envelope := &serialization.CallTableSerializationEnvelope{}
+	binaryPayload, remainder, err := envelope.FromBytes(maxExpectedFields, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	window, err := binaryPayload.StartConsuming()
+	if err != nil || window == nil {
+		return nil, nil, serialization.ErrFormatting
+	}
+	return window, remainder, nil
+}
+
+// expectField verifies that the next field of the call table is present and has the expected index.
+func expectField(window *serialization.CallTableFieldsIterator, index uint16) error {
+	if window == nil {
+		return serialization.ErrFormatting
+	}
+	return window.VerifyIndex(index)
+}
+
+// hashFromBytesDecoder decodes the fixed size hash serialized without the length prefix.
+type hashFromBytesDecoder struct{}
+
+func (d *hashFromBytesDecoder) FromBytes(source []byte) (key.Hash, []byte, error) {
+	return decodeHash(source)
+}
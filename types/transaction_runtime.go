@@ -98,6 +98,58 @@ func (t *TransactionRuntime) Bytes() ([]byte, error) {
 	return builder.BinaryPayloadBytes()
 }
 
+type TransactionRuntimeFromBytesDecoder struct{}
+
+func (d *TransactionRuntimeFromBytesDecoder) FromBytes(source []byte) (*TransactionRuntime, []byte, error) {
+	window, remainder, err := startCallTable(3, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = window.VerifyIndex(TagFieldIndex); err != nil {
+		return nil, nil, err
+	}
+	tag, nextWindow, err := serialization.DeserializeAndMaybeNext[uint8](window, &encoding.U8FromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch tag {
+	case TransactionRuntimeTagVmCasperV1:
+		if nextWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+		runtime := NewVmCasperV1TransactionRuntime()
+		return &runtime, remainder, nil
+	case TransactionRuntimeTagVmCasperV2:
+		if err = expectField(nextWindow, TransferredValueIndex); err != nil {
+			return nil, nil, err
+		}
+		transferredValue, nextWindow, err := serialization.DeserializeAndMaybeNext[uint64](nextWindow, &encoding.U64FromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = expectField(nextWindow, SeedValueIndex); err != nil {
+			return nil, nil, err
+		}
+		seed, nextWindow, err := serialization.DeserializeAndMaybeNext[encoding.Option[key.Hash]](nextWindow, &encoding.OptionFromBytesDecoder[key.Hash, *hashFromBytesDecoder]{
+			Decoder: &hashFromBytesDecoder{},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if nextWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+
+		runtime := NewVmCasperV2TransactionRuntime(transferredValue, seed.Some)
+		return &runtime, remainder, nil
+	default:
+		return nil, nil, serialization.ErrFormatting
+	}
+}
+
 func (t *TransactionRuntime) SerializedLength() int {
 	envelope := serialization.CallTableSerializationEnvelope{}
 	return envelope.EstimateSize(t.serializedFieldLengths())
@@ -152,6 +152,57 @@ func (t *TransactionScheduling) Bytes() ([]byte, error) {
 	return builder.BinaryPayloadBytes()
 }
 
+type TransactionSchedulingFromBytesDecoder struct{}
+
+func (d *TransactionSchedulingFromBytesDecoder) FromBytes(source []byte) (*TransactionScheduling, []byte, error) {
+	window, remainder, err := startCallTable(2, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = window.VerifyIndex(TagFieldIndex); err != nil {
+		return nil, nil, err
+	}
+	tag, nextWindow, err := serialization.DeserializeAndMaybeNext[uint8](window, &encoding.U8FromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch tag {
+	case TransactionSchedulingStandardTag:
+		if nextWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+		return &TransactionScheduling{Standard: &struct{}{}}, remainder, nil
+	case TransactionSchedulingFutureEraTag:
+		if err = expectField(nextWindow, FutureEraEraIDIndex); err != nil {
+			return nil, nil, err
+		}
+		eraID, finalWindow, err := serialization.DeserializeAndMaybeNext[uint64](nextWindow, &encoding.U64FromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if finalWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+		return &TransactionScheduling{FutureEra: &FutureEraScheduling{EraID: eraID}}, remainder, nil
+	case TransactionSchedulingFutureTimestampTag:
+		if err = expectField(nextWindow, FutureTimestampTimestampIndex); err != nil {
+			return nil, nil, err
+		}
+		timestamp, finalWindow, err := serialization.DeserializeAndMaybeNext[*Timestamp](nextWindow, &TimestampFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if finalWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+		return &TransactionScheduling{FutureTimestamp: &FutureTimestampScheduling{TimeStamp: *timestamp}}, remainder, nil
+	default:
+		return nil, nil, serialization.ErrFormatting
+	}
+}
+
 func (d TransactionScheduling) serializedFieldLengths() []int {
 	switch {
 	case d.Standard != nil:
@@ -115,6 +115,94 @@ func (t *TransactionTarget) Bytes() ([]byte, error) {
 	return builder.BinaryPayloadBytes()
 }
 
+type TransactionTargetFromBytesDecoder struct{}
+
+func (d *TransactionTargetFromBytesDecoder) FromBytes(source []byte) (*TransactionTarget, []byte, error) {
+	window, remainder, err := startCallTable(4, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = window.VerifyIndex(TagFieldIndex); err != nil {
+		return nil, nil, err
+	}
+	tag, nextWindow, err := serialization.DeserializeAndMaybeNext[uint8](window, &encoding.U8FromBytesDecoder{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch tag {
+	case TransactionTargetTypeNative:
+		if nextWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+		return &TransactionTarget{Native: &struct{}{}}, remainder, nil
+	case TransactionTargetTypeStored:
+		if err = expectField(nextWindow, StoredIdIndex); err != nil {
+			return nil, nil, err
+		}
+		id, nextWindow, err := serialization.DeserializeAndMaybeNext[*TransactionInvocationTarget](nextWindow, &TransactionInvocationTargetFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = expectField(nextWindow, StoredRuntimeIndex); err != nil {
+			return nil, nil, err
+		}
+		runtime, nextWindow, err := serialization.DeserializeAndMaybeNext[*TransactionRuntime](nextWindow, &TransactionRuntimeFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if nextWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+
+		return &TransactionTarget{
+			Stored: &StoredTarget{
+				ID:      *id,
+				Runtime: *runtime,
+			},
+		}, remainder, nil
+	case TransactionTargetTypeSession:
+		if err = expectField(nextWindow, SessionIsInstallIndex); err != nil {
+			return nil, nil, err
+		}
+		isInstallUpgrade, nextWindow, err := serialization.DeserializeAndMaybeNext[bool](nextWindow, encoding.NewBoolFromBytesDecoder())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = expectField(nextWindow, SessionRuntimeIndex); err != nil {
+			return nil, nil, err
+		}
+		runtime, nextWindow, err := serialization.DeserializeAndMaybeNext[*TransactionRuntime](nextWindow, &TransactionRuntimeFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = expectField(nextWindow, SessionModuleBytesIndex); err != nil {
+			return nil, nil, err
+		}
+		moduleBytes, nextWindow, err := serialization.DeserializeAndMaybeNext[[]byte](nextWindow, &encoding.BytesFromBytesDecoder{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if nextWindow != nil {
+			return nil, nil, serialization.ErrFormatting
+		}
+
+		return &TransactionTarget{
+			Session: &SessionTarget{
+				ModuleBytes:      moduleBytes,
+				Runtime:          *runtime,
+				IsInstallUpgrade: isInstallUpgrade,
+			},
+		}, remainder, nil
+	default:
+		return nil, nil, serialization.ErrFormatting
+	}
+}
+
 func (t TransactionTarget) serializedFieldLengths() []int {
 	switch {
 	case t.Native != nil: